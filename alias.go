@@ -0,0 +1,83 @@
+package simplejsonx
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+	"github.com/yyle88/simplejsonx/internal/utils"
+)
+
+// ExtractFirst parses the value of the first key present in object, trying keys in order
+// Returns the matched key as well, errors when no key is present or conversion fails
+// Suited to fields renamed between API versions, such as "user_id", "userId", "uid"
+//
+// ExtractFirst 按顺序尝试 keys，解析对象中第一个存在的键的值
+// 同时返回匹配到的键，当所有键都不存在或转换失败时返回错误
+// 适用于在不同 API 版本之间改名的字段，例如 "user_id"、"userId"、"uid"
+func ExtractFirst[T any](object *simplejson.Json, keys ...string) (T, string, error) {
+	if object == nil {
+		return utils.Zero[T](), "", errors.New("parameter object is missing")
+	}
+	if len(keys) == 0 {
+		return utils.Zero[T](), "", errors.New("parameter keys is missing")
+	}
+	for _, key := range keys {
+		res, exist, err := Inquire[T](object, key)
+		if err != nil {
+			return utils.Zero[T](), key, err
+		}
+		if exist {
+			return res, key, nil
+		}
+	}
+	return utils.Zero[T](), "", errors.Errorf("unable to find any of keys %q in JSON object", keys)
+}
+
+// ExploreFirst parses the value of the first dot-separated path present in object, trying paths in order
+// Returns the matched path as well, errors when no path is present or conversion fails
+//
+// ExploreFirst 按顺序尝试 paths，解析对象中第一个存在的点分隔路径的值
+// 同时返回匹配到的路径，当所有路径都不存在或转换失败时返回错误
+func ExploreFirst[T any](object *simplejson.Json, paths ...string) (T, string, error) {
+	if object == nil {
+		return utils.Zero[T](), "", errors.New("parameter object is missing")
+	}
+	if len(paths) == 0 {
+		return utils.Zero[T](), "", errors.New("parameter paths is missing")
+	}
+	for _, path := range paths {
+		res, exist, err := Explore[T](object, path)
+		if err != nil {
+			return utils.Zero[T](), path, err
+		}
+		if exist {
+			return res, path, nil
+		}
+	}
+	return utils.Zero[T](), "", errors.Errorf("unable to find any of paths %q in JSON object", paths)
+}
+
+// Aliases maps canonical key to its alternative names, such as "user_id" to {"userId", "uid"}
+// Lookups try the canonical key first and then the names in order, names are not looked up as canonical keys again
+// Attach the table to Document via WithAliases, or to Node via WithAliases, so their accessors consult it
+// The table is consulted at lookup time, documents are never modified
+//
+// Aliases 将规范键映射到其别名，例如 "user_id" 映射到 {"userId", "uid"}
+// 查找时先尝试规范键，再按顺序尝试别名，别名不会再被当作规范键继续查找
+// 通过 Document 或 Node 的 WithAliases 附加别名表后，其访问函数都会使用它
+// 别名表在查找时使用，不会修改文档
+type Aliases map[string][]string
+
+// lookupAlias finds the value at key or at the first alias of key present, returning the actual key found
+//
+// lookupAlias 查找 key 处或 key 的第一个存在的别名处的值，并返回实际找到的键
+func lookupAlias(object *simplejson.Json, key string, aliases Aliases) (*simplejson.Json, string, bool) {
+	if value, exist := object.CheckGet(key); exist {
+		return value, key, true
+	}
+	for _, name := range aliases[key] {
+		if value, exist := object.CheckGet(name); exist {
+			return value, name, true
+		}
+	}
+	return nil, "", false
+}
@@ -0,0 +1,125 @@
+package simplejsonx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestExtractFirst(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"userId": 7, "uid": 8, "name": "a"}`))
+	require.NoError(t, err)
+
+	id, key, err := simplejsonx.ExtractFirst[int](object, "user_id", "userId", "uid")
+	require.NoError(t, err)
+	require.Equal(t, 7, id)
+	require.Equal(t, "userId", key)
+
+	_, _, err = simplejsonx.ExtractFirst[int](object, "user_id", "user")
+	require.Error(t, err)
+
+	_, key, err = simplejsonx.ExtractFirst[int](object, "name", "uid")
+	require.Error(t, err)
+	require.Equal(t, "name", key)
+}
+
+func TestExploreFirst(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"data": {"user": {"uid": 8}}}`))
+	require.NoError(t, err)
+
+	id, path, err := simplejsonx.ExploreFirst[int64](object, "data.user.user_id", "data.user.uid")
+	require.NoError(t, err)
+	require.Equal(t, int64(8), id)
+	require.Equal(t, "data.user.uid", path)
+
+	_, _, err = simplejsonx.ExploreFirst[int64](object, "data.user.id")
+	require.Error(t, err)
+}
+
+func TestDocument_WithAliases(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"uid": 1, "userId": 2, "name": "a"}`))
+	require.NoError(t, err)
+	document := simplejsonx.NewDocument(object).WithAliases(simplejsonx.Aliases{"user_id": {"userId", "uid"}, "nick": {"name"}})
+
+	id, err := simplejsonx.ExtractIn[int](document, object, "user_id")
+	require.NoError(t, err)
+	require.Equal(t, 2, id)
+
+	id, err = simplejsonx.ExtractIn[int](document, object, "uid")
+	require.NoError(t, err)
+	require.Equal(t, 1, id)
+
+	_, err = simplejsonx.ExtractIn[int](document, object, "nick")
+	require.Error(t, err)
+
+	_, err = simplejsonx.ExtractIn[int](document, object, "age")
+	require.Error(t, err)
+
+	_, err = simplejsonx.ExtractIn[int](simplejsonx.NewDocument(object), object, "user_id")
+	require.Error(t, err) // the table belongs to the document it was attached to
+
+	_, exist := object.CheckGet("user_id")
+	require.False(t, exist) // lookups never modify the document
+}
+
+func TestDocument_WithAliases_Inquire(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"c": 1}`))
+	require.NoError(t, err)
+	document := simplejsonx.NewDocument(object).WithAliases(simplejsonx.Aliases{"a": {"b"}, "b": {"c"}})
+
+	_, exist, err := simplejsonx.InquireIn[int](document, object, "a")
+	require.NoError(t, err)
+	require.False(t, exist) // aliases are not chained
+
+	value, exist, err := simplejsonx.InquireIn[int](document, object, "b")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, 1, value)
+
+	value, err = simplejsonx.InspectIn[int](document, object, "a")
+	require.NoError(t, err)
+	require.Equal(t, 0, value)
+}
+
+func TestDocument_WithAliases_Explore(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"meta": {"userId": 2}, "orders": [{"orderId": "a"}]}`))
+	require.NoError(t, err)
+	document := simplejsonx.NewDocument(object).WithAliases(simplejsonx.Aliases{"user_id": {"userId"}, "metadata": {"meta"}, "order_id": {"orderId"}})
+
+	id, exist, err := simplejsonx.ExploreIn[int](document, "metadata.user_id")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, 2, id)
+
+	_, exist, err = simplejsonx.ExploreIn[int](document, "metadata.uid")
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	orderID, err := document.Node().Path("orders[0].order_id").String()
+	require.NoError(t, err)
+	require.Equal(t, "a", orderID)
+
+	_, err = simplejsonx.NewNode(object).Path("orders[0].order_id").String()
+	require.Error(t, err)
+
+	orderID, err = simplejsonx.NewNode(object).WithAliases(simplejsonx.Aliases{"order_id": {"orderId"}}).Path("orders[0].order_id").String()
+	require.NoError(t, err)
+	require.Equal(t, "a", orderID)
+}
+
+func TestDocument_WithAliases_Positions(t *testing.T) {
+	data := []byte("{\n  \"meta\": {\"userId\": \"x\"}\n}")
+	document, err := simplejsonx.LoadDocument(data, "user.json", nil)
+	require.NoError(t, err)
+	aliased := document.WithAliases(simplejsonx.Aliases{"user_id": {"userId"}})
+
+	_, _, err = simplejsonx.ExploreIn[int](aliased, "meta.user_id")
+	require.Error(t, err)
+	t.Log(err)
+	require.Contains(t, err.Error(), "user.json:2:22")
+
+	_, exist, err := simplejsonx.ExploreIn[int](document, "meta.user_id")
+	require.NoError(t, err)
+	require.False(t, exist) // WithAliases leaves the original document unchanged
+}
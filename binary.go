@@ -0,0 +1,178 @@
+package simplejsonx
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// ResolveBase64 decodes JSON string value holding base64 data
+// Accepts standard and URL-safe alphabets, with or without padding, detected from the text
+// Returns errors when value is not a string, when text mixes alphabets or is malformed
+//
+// ResolveBase64 解码包含 base64 数据的 JSON 字符串值
+// 接受标准和 URL 安全两种字母表，有无填充均可，根据文本自动识别
+// 当值不是字符串、文本混用字母表或格式错误时返回错误
+func ResolveBase64(object *simplejson.Json) ([]byte, error) {
+	text, err := resolveBinaryText(object)
+	if err != nil {
+		return nil, err
+	}
+	standard := strings.ContainsAny(text, "+/")
+	urlSafe := strings.ContainsAny(text, "-_")
+	if standard && urlSafe {
+		return nil, errors.New("unable to decode base64 JSON value: mixed standard and URL-safe alphabets")
+	}
+	encoding := base64.StdEncoding
+	if urlSafe {
+		encoding = base64.URLEncoding
+	}
+	if !strings.HasSuffix(text, "=") {
+		encoding = encoding.WithPadding(base64.NoPadding)
+	}
+	res, err := encoding.DecodeString(text)
+	if err != nil {
+		return nil, errors.WithMessage(err, "unable to decode base64 JSON value")
+	}
+	return res, nil
+}
+
+// ResolveHex decodes JSON string value holding hexadecimal data, both letter cases are accepted
+//
+// ResolveHex 解码包含十六进制数据的 JSON 字符串值，大小写字母均可接受
+func ResolveHex(object *simplejson.Json) ([]byte, error) {
+	text, err := resolveBinaryText(object)
+	if err != nil {
+		return nil, err
+	}
+	res, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, errors.WithMessage(err, "unable to decode hex JSON value")
+	}
+	return res, nil
+}
+
+// ResolveBase32 decodes JSON string value holding standard base32 data, with or without padding
+//
+// ResolveBase32 解码包含标准 base32 数据的 JSON 字符串值，有无填充均可
+func ResolveBase32(object *simplejson.Json) ([]byte, error) {
+	text, err := resolveBinaryText(object)
+	if err != nil {
+		return nil, err
+	}
+	encoding := base32.StdEncoding
+	if !strings.HasSuffix(text, "=") {
+		encoding = encoding.WithPadding(base32.NoPadding)
+	}
+	res, err := encoding.DecodeString(text)
+	if err != nil {
+		return nil, errors.WithMessage(err, "unable to decode base32 JSON value")
+	}
+	return res, nil
+}
+
+// ExtractBase64 retrieves the value at the specified key and decodes it via ResolveBase64
+//
+// ExtractBase64 检索指定键的值并通过 ResolveBase64 解码
+func ExtractBase64(object *simplejson.Json, key string) ([]byte, error) {
+	return extractBinary(object, key, ResolveBase64)
+}
+
+// ExtractHex retrieves the value at the specified key and decodes it via ResolveHex
+//
+// ExtractHex 检索指定键的值并通过 ResolveHex 解码
+func ExtractHex(object *simplejson.Json, key string) ([]byte, error) {
+	return extractBinary(object, key, ResolveHex)
+}
+
+// ExtractBase32 retrieves the value at the specified key and decodes it via ResolveBase32
+//
+// ExtractBase32 检索指定键的值并通过 ResolveBase32 解码
+func ExtractBase32(object *simplejson.Json, key string) ([]byte, error) {
+	return extractBinary(object, key, ResolveBase32)
+}
+
+// WrapBase64 creates simplejson.Json holding data as padded standard base64 string
+//
+// WrapBase64 创建以带填充的标准 base64 字符串保存 data 的 simplejson.Json
+func WrapBase64(data []byte) *simplejson.Json {
+	return Wrap(base64.StdEncoding.EncodeToString(data))
+}
+
+// WrapHex creates simplejson.Json holding data as lowercase hexadecimal string
+//
+// WrapHex 创建以小写十六进制字符串保存 data 的 simplejson.Json
+func WrapHex(data []byte) *simplejson.Json {
+	return Wrap(hex.EncodeToString(data))
+}
+
+// WrapBase32 creates simplejson.Json holding data as padded standard base32 string
+//
+// WrapBase32 创建以带填充的标准 base32 字符串保存 data 的 simplejson.Json
+func WrapBase32(data []byte) *simplejson.Json {
+	return Wrap(base32.StdEncoding.EncodeToString(data))
+}
+
+// SetBase64 stores data at the specified key as padded standard base64 string
+//
+// SetBase64 将 data 以带填充的标准 base64 字符串存储到指定键
+func SetBase64(object *simplejson.Json, key string, data []byte) error {
+	return setBinary(object, key, base64.StdEncoding.EncodeToString(data))
+}
+
+// SetHex stores data at the specified key as lowercase hexadecimal string
+//
+// SetHex 将 data 以小写十六进制字符串存储到指定键
+func SetHex(object *simplejson.Json, key string, data []byte) error {
+	return setBinary(object, key, hex.EncodeToString(data))
+}
+
+// SetBase32 stores data at the specified key as padded standard base32 string
+//
+// SetBase32 将 data 以带填充的标准 base32 字符串存储到指定键
+func SetBase32(object *simplejson.Json, key string, data []byte) error {
+	return setBinary(object, key, base32.StdEncoding.EncodeToString(data))
+}
+
+func resolveBinaryText(object *simplejson.Json) (string, error) {
+	if object == nil {
+		return "", errors.New("parameter object is missing")
+	}
+	text, err := object.String()
+	if err != nil {
+		return "", errors.WithMessage(err, "unable to resolve JSON value to string")
+	}
+	return text, nil
+}
+
+func extractBinary(object *simplejson.Json, key string, resolve func(*simplejson.Json) ([]byte, error)) ([]byte, error) {
+	if object == nil {
+		return nil, errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return nil, errors.New("parameter key is missing")
+	}
+	res, err := resolve(object.Get(key))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func setBinary(object *simplejson.Json, key string, text string) error {
+	if object == nil {
+		return errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return errors.New("parameter key is missing")
+	}
+	if _, err := object.Map(); err != nil {
+		return errors.WithMessage(err, "unable to set value on non-object JSON")
+	}
+	object.Set(key, text)
+	return nil
+}
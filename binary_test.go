@@ -0,0 +1,104 @@
+package simplejsonx_test
+
+import (
+	"testing"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestResolveBase64(t *testing.T) {
+	data := []byte{0xfb, 0xff, 0xfe, 0x01}
+	for _, text := range []string{"+//+AQ==", "+//+AQ", "-__-AQ==", "-__-AQ"} {
+		res, err := simplejsonx.ResolveBase64(simplejsonx.Wrap(text))
+		require.NoError(t, err, text)
+		require.Equal(t, data, res, text)
+	}
+
+	_, err := simplejsonx.ResolveBase64(simplejsonx.Wrap("+/-_"))
+	require.Error(t, err)
+
+	_, err = simplejsonx.ResolveBase64(simplejsonx.Wrap("a!b="))
+	require.Error(t, err)
+
+	_, err = simplejsonx.ResolveBase64(simplejsonx.Wrap(18))
+	require.Error(t, err)
+}
+
+func TestResolveHex(t *testing.T) {
+	res, err := simplejsonx.ResolveHex(simplejsonx.Wrap("00ffAB"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x00, 0xff, 0xab}, res)
+
+	_, err = simplejsonx.ResolveHex(simplejsonx.Wrap("0g"))
+	require.Error(t, err)
+
+	_, err = simplejsonx.ResolveHex(simplejsonx.Wrap("abc"))
+	require.Error(t, err)
+}
+
+func TestResolveBase32(t *testing.T) {
+	for _, text := range []string{"MZXW6===", "MZXW6"} {
+		res, err := simplejsonx.ResolveBase32(simplejsonx.Wrap(text))
+		require.NoError(t, err, text)
+		require.Equal(t, "foo", string(res))
+	}
+
+	_, err := simplejsonx.ResolveBase32(simplejsonx.Wrap("mzxw1"))
+	require.Error(t, err)
+}
+
+func TestExtractBase64(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"avatar": "aGVsbG8=", "digest": "68656c6c6f", "code": "NBSWY3DP"}`))
+	require.NoError(t, err)
+
+	res, err := simplejsonx.ExtractBase64(object, "avatar")
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(res))
+
+	res, err = simplejsonx.ExtractHex(object, "digest")
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(res))
+
+	res, err = simplejsonx.ExtractBase32(object, "code")
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(res))
+
+	_, err = simplejsonx.ExtractBase64(object, "missing")
+	require.Error(t, err)
+}
+
+func TestSetBase64(t *testing.T) {
+	data := []byte{0xfb, 0xff, 0x00}
+	object := simplejson.New()
+	require.NoError(t, simplejsonx.SetBase64(object, "b64", data))
+	require.NoError(t, simplejsonx.SetHex(object, "hex", data))
+	require.NoError(t, simplejsonx.SetBase32(object, "b32", data))
+	require.Equal(t, "+/8A", object.Get("b64").MustString())
+	require.Equal(t, "fbff00", object.Get("hex").MustString())
+
+	for key, resolve := range map[string]func(*simplejson.Json) ([]byte, error){
+		"b64": simplejsonx.ResolveBase64,
+		"hex": simplejsonx.ResolveHex,
+		"b32": simplejsonx.ResolveBase32,
+	} {
+		res, err := resolve(object.Get(key))
+		require.NoError(t, err, key)
+		require.Equal(t, data, res, key)
+	}
+
+	res, err := simplejsonx.ResolveBase64(simplejsonx.WrapBase64(data))
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+
+	res, err = simplejsonx.ResolveHex(simplejsonx.WrapHex(data))
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+
+	res, err = simplejsonx.ResolveBase32(simplejsonx.WrapBase32(data))
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+
+	require.Error(t, simplejsonx.SetHex(simplejsonx.Wrap([]interface{}{}), "hex", data))
+}
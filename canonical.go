@@ -0,0 +1,264 @@
+package simplejsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"hash"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// Canonical serializes JSON document using RFC 8785 JSON Canonicalization Scheme
+// Object keys are sorted by UTF-16 code units, numbers use ECMAScript shortest form
+// Strings use minimal escaping, so equal documents always produce identical bytes
+// Returns errors on NaN, Infinity and invalid UTF-8 strings which have no canonical form
+//
+// Canonical 使用 RFC 8785 JSON 规范化方案序列化 JSON 文档
+// 对象的键按 UTF-16 编码单元排序，数字使用 ECMAScript 最短表示形式
+// 字符串使用最少转义，使相等的文档总是生成相同的字节
+// 遇到 NaN、Infinity 和非法 UTF-8 字符串时返回错误，因为它们没有规范形式
+func Canonical(object *simplejson.Json) ([]byte, error) {
+	if object == nil {
+		return nil, errors.New("parameter object is missing")
+	}
+	var buffer bytes.Buffer
+	if err := writeCanonical(&buffer, object.Interface()); err != nil {
+		return nil, errors.WithMessage(err, "unable to canonicalize JSON")
+	}
+	return buffer.Bytes(), nil
+}
+
+// Fingerprint computes content hash of JSON document over its canonical form
+// Resets the hash before writing, so one hash.Hash instance can be reused
+// Documents that differ only in key order or number representation share one fingerprint
+//
+// Fingerprint 基于 JSON 文档的规范形式计算内容哈希
+// 写入前会重置哈希，因此同一个 hash.Hash 实例可以重复使用
+// 仅在键顺序或数字表示形式上不同的文档具有相同的指纹
+func Fingerprint(object *simplejson.Json, h hash.Hash) ([]byte, error) {
+	if h == nil {
+		return nil, errors.New("parameter hash is missing")
+	}
+	data, err := Canonical(object)
+	if err != nil {
+		return nil, err
+	}
+	h.Reset()
+	if _, err := h.Write(data); err != nil {
+		return nil, errors.WithMessage(err, "unable to write hash")
+	}
+	return h.Sum(nil), nil
+}
+
+func writeCanonical(buffer *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buffer.WriteString("null")
+		return nil
+	case bool:
+		buffer.WriteString(strconv.FormatBool(v))
+		return nil
+	case string:
+		return writeCanonicalString(buffer, v)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUTF16(keys[i], keys[j])
+		})
+		buffer.WriteByte('{')
+		for idx, key := range keys {
+			if idx > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := writeCanonicalString(buffer, key); err != nil {
+				return err
+			}
+			buffer.WriteByte(':')
+			if err := writeCanonical(buffer, v[key]); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+		return nil
+	case []interface{}:
+		buffer.WriteByte('[')
+		for idx, elem := range v {
+			if idx > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := writeCanonical(buffer, elem); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+		return nil
+	}
+	if number, ok := numberToFloat(value); ok {
+		res, err := formatCanonicalNumber(number)
+		if err != nil {
+			return err
+		}
+		buffer.WriteString(res)
+		return nil
+	}
+	normalized, err := normalizeValue(value)
+	if err != nil {
+		return err
+	}
+	return writeCanonical(buffer, normalized)
+}
+
+// normalizeValue converts Go value outside the generic JSON tree into generic form for serializers
+// Typed maps and slices become generic containers, other values such as structs and custom marshalers
+// go through encoding/json once, serializers format native numbers themselves before calling it
+// The result is nil, bool, string, json.Number, map[string]interface{} or []interface{},
+// elements of converted typed containers may still be native numbers
+//
+// normalizeValue 将通用 JSON 树之外的 Go 值转换成供序列化器使用的通用形式
+// 具体类型的映射和切片转换成通用容器，结构体和自定义序列化器等其它值通过 encoding/json 转换一次，
+// 序列化器在调用它之前自行格式化原生数字
+// 结果是 nil、bool、string、json.Number、map[string]interface{} 或 []interface{} 之一，
+// 转换后的具体类型容器中的元素仍可能是原生数字
+func normalizeValue(value interface{}) (interface{}, error) {
+	switch value.(type) {
+	case nil, bool, string, json.Number, map[string]interface{}, []interface{}:
+		return value, nil
+	}
+	switch generic := toGeneric(value); generic.(type) {
+	case map[string]interface{}, []interface{}:
+		return generic, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "unable to marshal %T", value)
+	}
+	decoded, err := Load(data)
+	if err != nil {
+		return nil, err
+	}
+	return decoded.Interface(), nil
+}
+
+// numberToFloat converts numeric representations into float64 as RFC 8785 requires
+//
+// numberToFloat 按照 RFC 8785 的要求将数字表示转换成 float64
+func numberToFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		res, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return math.NaN(), true
+		}
+		return res, true
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// formatCanonicalNumber formats float64 the same way as ECMAScript Number.prototype.toString
+//
+// formatCanonicalNumber 按照 ECMAScript Number.prototype.toString 的方式格式化 float64
+func formatCanonicalNumber(number float64) (string, error) {
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return "", errors.Errorf("number %v is not representable in canonical JSON", number)
+	}
+	if number == 0 {
+		return "0", nil // also covers negative zero
+	}
+	format := byte('f')
+	if abs := math.Abs(number); abs < 1e-6 || abs >= 1e21 {
+		format = 'e'
+	}
+	res := strconv.FormatFloat(number, format, -1, 64)
+	if format == 'e' {
+		// ECMAScript writes "1e-7" where Go writes "1e-07"
+		if n := len(res); n >= 4 && res[n-4] == 'e' && res[n-2] == '0' {
+			res = res[:n-2] + res[n-1:]
+		}
+	}
+	return res, nil
+}
+
+func writeCanonicalString(buffer *bytes.Buffer, s string) error {
+	if !utf8.ValidString(s) {
+		return errors.Errorf("string %q is not valid UTF-8", s)
+	}
+	const hexDigits = "0123456789abcdef"
+	buffer.WriteByte('"')
+	for idx := 0; idx < len(s); idx++ {
+		c := s[idx]
+		switch c {
+		case '"':
+			buffer.WriteString(`\"`)
+		case '\\':
+			buffer.WriteString(`\\`)
+		case '\b':
+			buffer.WriteString(`\b`)
+		case '\f':
+			buffer.WriteString(`\f`)
+		case '\n':
+			buffer.WriteString(`\n`)
+		case '\r':
+			buffer.WriteString(`\r`)
+		case '\t':
+			buffer.WriteString(`\t`)
+		default:
+			if c < 0x20 {
+				buffer.WriteString(`\u00`)
+				buffer.WriteByte(hexDigits[c>>4])
+				buffer.WriteByte(hexDigits[c&0xF])
+			} else {
+				buffer.WriteByte(c)
+			}
+		}
+	}
+	buffer.WriteByte('"')
+	return nil
+}
+
+// lessUTF16 compares strings by UTF-16 code units as RFC 8785 requires for key ordering
+//
+// lessUTF16 按照 RFC 8785 键排序的要求，以 UTF-16 编码单元比较字符串
+func lessUTF16(a, b string) bool {
+	ua := utf16.Encode([]rune(a))
+	ub := utf16.Encode([]rune(b))
+	for idx := 0; idx < len(ua) && idx < len(ub); idx++ {
+		if ua[idx] != ub[idx] {
+			return ua[idx] < ub[idx]
+		}
+	}
+	return len(ua) < len(ub)
+}
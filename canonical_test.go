@@ -0,0 +1,72 @@
+package simplejsonx_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestCanonical(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{
+		"b": [1.0, 2.50, -0, 1e21, 1e-7, 0.000001, 100],
+		"a": "line\nbreak \"quote\" \u0001 <html>  ",
+		"c": {"z": null, "y": true}
+	}`))
+	require.NoError(t, err)
+
+	data, err := simplejsonx.Canonical(object)
+	require.NoError(t, err)
+	t.Log(string(data))
+	require.Equal(t, `{"a":"line\nbreak \"quote\" \u0001 <html> `+" "+`","b":[1,2.5,0,1e+21,1e-7,0.000001,100],"c":{"y":true,"z":null}}`, string(data))
+}
+
+func TestCanonical_KeyOrderUTF16(t *testing.T) {
+	// U+1F600 sorts before U+FB33 in UTF-16 (surrogate 0xD83D) though after it in UTF-8
+	object := simplejsonx.Wrap(map[string]interface{}{"דּ": 1, "\U0001F600": 2, "a": 3})
+
+	data, err := simplejsonx.Canonical(object)
+	require.NoError(t, err)
+	require.Equal(t, `{"a":3,"`+"\U0001F600"+`":2,"`+"דּ"+`":1}`, string(data))
+}
+
+func TestCanonical_NumberRepresentations(t *testing.T) {
+	loaded, err := simplejsonx.Load([]byte(`{"n": 1, "f": 0.1, "tags": ["x"]}`))
+	require.NoError(t, err)
+	wrapped := simplejsonx.Wrap(map[string]interface{}{"tags": []string{"x"}, "f": 0.1, "n": int64(1)})
+
+	a, err := simplejsonx.Canonical(loaded)
+	require.NoError(t, err)
+	b, err := simplejsonx.Canonical(wrapped)
+	require.NoError(t, err)
+	require.Equal(t, string(a), string(b))
+}
+
+func TestCanonical_Invalid(t *testing.T) {
+	_, err := simplejsonx.Canonical(simplejsonx.Wrap(math.Inf(1)))
+	require.Error(t, err)
+	t.Log(err)
+
+	_, err = simplejsonx.Canonical(nil)
+	require.Error(t, err)
+}
+
+func TestFingerprint(t *testing.T) {
+	a, err := simplejsonx.Load([]byte(`{"id": 1, "name": "abc"}`))
+	require.NoError(t, err)
+	b, err := simplejsonx.Load([]byte(`{"name":"abc","id":1.0}`))
+	require.NoError(t, err)
+
+	h := sha256.New()
+	sumA, err := simplejsonx.Fingerprint(a, h)
+	require.NoError(t, err)
+	sumB, err := simplejsonx.Fingerprint(b, h)
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(sumA), hex.EncodeToString(sumB))
+
+	expected := sha256.Sum256([]byte(`{"id":1,"name":"abc"}`))
+	require.Equal(t, expected[:], sumA)
+}
@@ -0,0 +1,122 @@
+package simplejsonx
+
+import (
+	"reflect"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// Clone creates deep copy of simplejson.Json instance
+// Copies maps, slices and arrays of any element type, typed ones from Wrap included, keeping their Go types
+// Structs and pointers given to Wrap are copied as-is and stay shared with the source
+//
+// Clone 创建 simplejson.Json 实例的深拷贝
+// 复制任意元素类型的映射、切片和数组（包括来自 Wrap 的具体类型容器），并保持其 Go 类型
+// 传给 Wrap 的结构体和指针按原样复制，仍与源对象共享
+func Clone(object *simplejson.Json) *simplejson.Json {
+	if object == nil {
+		return simplejson.New()
+	}
+	return Wrap(cloneValue(object.Interface()))
+}
+
+// ListClone converts slice of interface{} values into independent simplejson.Json objects
+// Each element is deep copied, mutating the results leaves source elements untouched
+//
+// ListClone 将 interface{} 值切片转换成相互独立的 simplejson.Json 对象切片
+// 每个元素都会被深拷贝，修改结果不会影响源数据
+func ListClone(elements []interface{}) (objects []*simplejson.Json) {
+	objects = make([]*simplejson.Json, 0, len(elements))
+	for _, elem := range elements {
+		objects = append(objects, Wrap(cloneValue(elem)))
+	}
+	return objects
+}
+
+// GetListClone retrieves JSON list at the specified key as independent simplejson.Json objects
+// Same as GetList except that elements are deep copied from the source document
+//
+// GetListClone 检索指定键的 JSON 数组并转换成相互独立的 simplejson.Json 对象切片
+// 与 GetList 相同，但元素会从源文档深拷贝
+func GetListClone(object *simplejson.Json, key string) (objects []*simplejson.Json, err error) {
+	if object == nil {
+		return nil, errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return nil, errors.New("parameter key is missing")
+	}
+	elements, err := object.Get(key).Array()
+	if err != nil {
+		return objects, errors.WithMessage(err, "unable to get list")
+	}
+	return ListClone(elements), nil
+}
+
+// cloneValue deep copies JSON containers recursively, typed maps and slices go through reflection
+//
+// cloneValue 递归深拷贝 JSON 容器，具体类型的映射和切片通过反射复制
+func cloneValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			res[key] = cloneValue(elem)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for idx, elem := range v {
+			res[idx] = cloneValue(elem)
+		}
+		return res
+	case nil, string, bool:
+		return v
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Interface:
+		return cloneReflect(rv).Interface()
+	default:
+		return value
+	}
+}
+
+func cloneReflect(rv reflect.Value) reflect.Value {
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv
+		}
+		res := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			res.SetMapIndex(iter.Key(), cloneReflect(iter.Value()))
+		}
+		return res
+	case reflect.Slice:
+		if rv.IsNil() {
+			return rv
+		}
+		res := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for idx := 0; idx < rv.Len(); idx++ {
+			res.Index(idx).Set(cloneReflect(rv.Index(idx)))
+		}
+		return res
+	case reflect.Array:
+		res := reflect.New(rv.Type()).Elem()
+		for idx := 0; idx < rv.Len(); idx++ {
+			res.Index(idx).Set(cloneReflect(rv.Index(idx)))
+		}
+		return res
+	case reflect.Interface:
+		if rv.IsNil() {
+			return rv
+		}
+		res := reflect.New(rv.Type()).Elem()
+		res.Set(cloneReflect(rv.Elem()))
+		return res
+	default:
+		return rv
+	}
+}
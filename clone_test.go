@@ -0,0 +1,89 @@
+package simplejsonx_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestClone(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"name": "yyle88", "info": {"age": 18, "tags": ["a", "b"]}}`))
+	require.NoError(t, err)
+
+	clone := simplejsonx.Clone(object)
+	clone.SetPath([]string{"info", "age"}, 20)
+	clone.Get("info").Get("tags").MustArray()[0] = "x"
+
+	age, err := simplejsonx.Extract[int](object.Get("info"), "age")
+	require.NoError(t, err)
+	require.Equal(t, 18, age)
+
+	tags, err := simplejsonx.Extract[[]string](object.Get("info"), "tags")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, tags)
+
+	require.Equal(t, json.Number("18"), object.GetPath("info", "age").Interface())
+}
+
+func TestClone_TypedContainers(t *testing.T) {
+	source := map[string]interface{}{
+		"m":      map[string]string{"k": "v"},
+		"nums":   []int{1, 2},
+		"groups": map[string][]string{"g": {"a"}},
+		"pairs":  [2][]int{{1}, {2}},
+	}
+	object := simplejsonx.Wrap(source)
+
+	clone := simplejsonx.Clone(object)
+	clone.Get("m").Interface().(map[string]string)["k"] = "x"
+	clone.Get("nums").Interface().([]int)[0] = 9
+	clone.Get("groups").Interface().(map[string][]string)["g"][0] = "z"
+	pairs := clone.Get("pairs").Interface().([2][]int)
+	pairs[0][0] = 7
+
+	require.Equal(t, map[string]string{"k": "v"}, source["m"])
+	require.Equal(t, []int{1, 2}, source["nums"])
+	require.Equal(t, map[string][]string{"g": {"a"}}, source["groups"])
+	require.Equal(t, [2][]int{{1}, {2}}, source["pairs"])
+}
+
+func TestClone_Nil(t *testing.T) {
+	clone := simplejsonx.Clone(nil)
+	require.NotNil(t, clone)
+}
+
+func TestGetListClone(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"items": [{"name": "a"}, {"name": "b"}]}`))
+	require.NoError(t, err)
+
+	{
+		objects, err := simplejsonx.GetList(object, "items")
+		require.NoError(t, err)
+		objects[0].Set("name", "shared")
+
+		name, err := simplejsonx.Extract[string](object.Get("items").GetIndex(0), "name")
+		require.NoError(t, err)
+		require.Equal(t, "shared", name)
+	}
+	{
+		objects, err := simplejsonx.GetListClone(object, "items")
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		objects[1].Set("name", "copied")
+
+		name, err := simplejsonx.Extract[string](object.Get("items").GetIndex(1), "name")
+		require.NoError(t, err)
+		require.Equal(t, "b", name)
+	}
+}
+
+func TestGetListClone_Mismatch(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"items": "abc"}`))
+	require.NoError(t, err)
+
+	objects, err := simplejsonx.GetListClone(object, "items")
+	require.Error(t, err)
+	require.Empty(t, objects)
+}
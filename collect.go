@@ -0,0 +1,172 @@
+package simplejsonx
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// Map converts each element of the JSON array at dot-separated path via Resolve and transforms it with fn
+// Returning error from fn aborts and returns that error
+//
+// Map 通过 Resolve 转换点分隔路径处 JSON 数组的每个元素，并使用 fn 进行变换
+// fn 返回错误时中止并返回该错误
+func Map[T any, R any](object *simplejson.Json, path string, fn func(v T) (R, error)) ([]R, error) {
+	if fn == nil {
+		return nil, errors.New("parameter fn is missing")
+	}
+	elements, err := collectArray[T](object, path)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]R, 0, len(elements))
+	for _, elem := range elements {
+		res, err := fn(elem)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// Filter returns elements of the JSON array at dot-separated path for which fn reports true
+//
+// Filter 返回点分隔路径处 JSON 数组中 fn 判定为 true 的元素
+func Filter[T any](object *simplejson.Json, path string, fn func(v T) (bool, error)) ([]T, error) {
+	if fn == nil {
+		return nil, errors.New("parameter fn is missing")
+	}
+	elements, err := collectArray[T](object, path)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]T, 0, len(elements))
+	for _, elem := range elements {
+		keep, err := fn(elem)
+		if err != nil {
+			return nil, err
+		}
+		if keep {
+			results = append(results, elem)
+		}
+	}
+	return results, nil
+}
+
+// Reduce folds elements of the JSON array at dot-separated path into accumulator starting from init
+//
+// Reduce 从 init 开始将点分隔路径处 JSON 数组的元素依次折叠到累加值中
+func Reduce[T any, A any](object *simplejson.Json, path string, init A, fn func(acc A, v T) (A, error)) (A, error) {
+	if fn == nil {
+		return init, errors.New("parameter fn is missing")
+	}
+	elements, err := collectArray[T](object, path)
+	if err != nil {
+		return init, err
+	}
+	acc := init
+	for _, elem := range elements {
+		if acc, err = fn(acc, elem); err != nil {
+			return init, err
+		}
+	}
+	return acc, nil
+}
+
+// GroupBy groups elements of the JSON array at dot-separated path by the key fn computes
+// Elements keep their array order inside each group
+//
+// GroupBy 按 fn 计算的键对点分隔路径处 JSON 数组的元素分组
+// 每个分组内的元素保持原数组顺序
+func GroupBy[T any, K comparable](object *simplejson.Json, path string, fn func(v T) (K, error)) (map[K][]T, error) {
+	if fn == nil {
+		return nil, errors.New("parameter fn is missing")
+	}
+	elements, err := collectArray[T](object, path)
+	if err != nil {
+		return nil, err
+	}
+	groups := make(map[K][]T)
+	for _, elem := range elements {
+		key, err := fn(elem)
+		if err != nil {
+			return nil, err
+		}
+		groups[key] = append(groups[key], elem)
+	}
+	return groups, nil
+}
+
+// SortBy returns elements of the JSON array at dot-separated path stably sorted by less
+// The document itself is left unchanged
+//
+// SortBy 返回按 less 稳定排序后的点分隔路径处 JSON 数组元素
+// 文档本身不会被修改
+func SortBy[T any](object *simplejson.Json, path string, less func(a, b T) bool) ([]T, error) {
+	if less == nil {
+		return nil, errors.New("parameter less is missing")
+	}
+	elements, err := collectArray[T](object, path)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		return less(elements[i], elements[j])
+	})
+	return elements, nil
+}
+
+// Distinct returns elements of the JSON array at dot-separated path without duplicates, keeping first occurrences
+//
+// Distinct 返回点分隔路径处 JSON 数组去重后的元素，保留首次出现的元素
+func Distinct[T comparable](object *simplejson.Json, path string) ([]T, error) {
+	elements, err := collectArray[T](object, path)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[T]struct{}, len(elements))
+	results := make([]T, 0, len(elements))
+	for _, elem := range elements {
+		if _, exist := seen[elem]; exist {
+			continue
+		}
+		seen[elem] = struct{}{}
+		results = append(results, elem)
+	}
+	return results, nil
+}
+
+// collectArray navigates dot-separated path and converts each array element via Resolve
+//
+// collectArray 沿点分隔路径导航，并通过 Resolve 转换数组的每个元素
+func collectArray[T any](object *simplejson.Json, path string) ([]T, error) {
+	if object == nil {
+		return nil, errors.New("parameter object is missing")
+	}
+	if path == "" {
+		return nil, errors.New("parameter path is missing")
+	}
+	value := object
+	for _, key := range strings.Split(path, ".") {
+		var exist bool
+		if value, exist = value.CheckGet(key); !exist {
+			return nil, errors.Errorf("unable to find path %q in JSON object", path)
+		}
+	}
+	elements, err := value.Array()
+	if err != nil {
+		return nil, errors.WithMessage(err, "unable to get list")
+	}
+	results := make([]T, 0, len(elements))
+	for idx, elem := range List(elements) {
+		res, err := Resolve[T](elem)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "unable to resolve element %d of %s", idx, path)
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
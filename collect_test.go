@@ -0,0 +1,116 @@
+package simplejsonx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+const collectData = `{"order": {"items": [
+	{"sku": "a", "category": "food", "amount": 30},
+	{"sku": "b", "category": "tool", "amount": 15},
+	{"sku": "c", "category": "food", "amount": 5}
+], "tags": ["x", "y", "x", "z", "y"]}}`
+
+func TestMap(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(collectData))
+	require.NoError(t, err)
+
+	skus, err := simplejsonx.Map(object, "order.items", func(v *simplejson.Json) (string, error) {
+		return simplejsonx.Extract[string](v, "sku")
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, skus)
+
+	_, err = simplejsonx.Map(object, "order.items", func(v *simplejson.Json) (int, error) {
+		return simplejsonx.Extract[int](v, "sku")
+	})
+	require.Error(t, err)
+
+	_, err = simplejsonx.Map(object, "order.missing", func(v *simplejson.Json) (int, error) { return 0, nil })
+	require.Error(t, err)
+
+	_, err = simplejsonx.Map(object, "order.tags", func(v int) (int, error) { return v, nil })
+	require.Error(t, err)
+}
+
+func TestFilter(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(collectData))
+	require.NoError(t, err)
+
+	large, err := simplejsonx.Filter(object, "order.items", func(v *simplejson.Json) (bool, error) {
+		amount, err := simplejsonx.Extract[int](v, "amount")
+		return amount >= 10, err
+	})
+	require.NoError(t, err)
+	require.Len(t, large, 2)
+}
+
+func TestReduce(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(collectData))
+	require.NoError(t, err)
+
+	total, err := simplejsonx.Reduce(object, "order.items", 0, func(acc int, v *simplejson.Json) (int, error) {
+		amount, err := simplejsonx.Extract[int](v, "amount")
+		return acc + amount, err
+	})
+	require.NoError(t, err)
+	require.Equal(t, 50, total)
+
+	failErr := errors.New("fail")
+	total, err = simplejsonx.Reduce(object, "order.items", -1, func(acc int, v *simplejson.Json) (int, error) {
+		return 0, failErr
+	})
+	require.ErrorIs(t, err, failErr)
+	require.Equal(t, -1, total)
+}
+
+func TestGroupBy(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(collectData))
+	require.NoError(t, err)
+
+	groups, err := simplejsonx.GroupBy(object, "order.items", func(v *simplejson.Json) (string, error) {
+		return simplejsonx.Extract[string](v, "category")
+	})
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	require.Len(t, groups["food"], 2)
+	require.Equal(t, "c", groups["food"][1].Get("sku").MustString())
+	require.Len(t, groups["tool"], 1)
+}
+
+func TestSortBy(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(collectData))
+	require.NoError(t, err)
+
+	items, err := simplejsonx.SortBy(object, "order.items", func(a, b *simplejson.Json) bool {
+		return a.Get("amount").MustInt() < b.Get("amount").MustInt()
+	})
+	require.NoError(t, err)
+	var skus []string
+	for _, item := range items {
+		skus = append(skus, item.Get("sku").MustString())
+	}
+	require.Equal(t, []string{"c", "b", "a"}, skus)
+
+	tags, err := simplejsonx.SortBy(object, "order.tags", func(a, b string) bool { return a < b })
+	require.NoError(t, err)
+	require.Equal(t, []string{"x", "x", "y", "y", "z"}, tags)
+}
+
+func TestDistinct(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(collectData))
+	require.NoError(t, err)
+
+	tags, err := simplejsonx.Distinct[string](object, "order.tags")
+	require.NoError(t, err)
+	require.Equal(t, []string{"x", "y", "z"}, tags)
+
+	tags, exist, err := simplejsonx.Explore[[]string](object, "order.tags")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Len(t, tags, 5)
+}
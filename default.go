@@ -0,0 +1,68 @@
+package simplejsonx
+
+import (
+	"strings"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// ExtractOr retrieves and parses the value at the specified key, returning def when key is missing or null
+// Type mismatches still return errors, unlike Attempt which hides them
+//
+// ExtractOr 检索指定键的值并解析成目标类型，当键缺失或值为 null 时返回 def
+// 类型不匹配时仍然返回错误，不像 Attempt 那样隐藏错误
+func ExtractOr[T any](object *simplejson.Json, key string, def T) (T, error) {
+	if object == nil {
+		return def, errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return def, errors.New("parameter key is missing")
+	}
+	value, exist := object.CheckGet(key)
+	return resolveOr(value, exist, def)
+}
+
+// InspectOr works like Inspect but returns def instead of zero value when key is missing or null
+// It is identical to ExtractOr: Extract and Inspect differ only in missing key handling, which def replaces
+// Both names exist so calls move from Extract or Inspect to the "Or" form by only adding the suffix and def
+//
+// InspectOr 与 Inspect 类似，但在键缺失或值为 null 时返回 def 而不是零值
+// 它与 ExtractOr 完全相同：Extract 和 Inspect 只在键缺失时的处理上不同，而这一处理已由 def 取代
+// 保留两个名称，使 Extract 或 Inspect 的调用只需添加 "Or" 后缀和 def 即可改成默认值形式
+func InspectOr[T any](object *simplejson.Json, key string, def T) (T, error) {
+	return ExtractOr(object, key, def)
+}
+
+// ExploreOr navigates dot-separated path like Explore, returning def when any key is missing or the value is null
+// Type mismatches at the final value still return errors
+//
+// ExploreOr 像 Explore 一样沿点分隔路径导航，当任一键缺失或值为 null 时返回 def
+// 最终值类型不匹配时仍然返回错误
+func ExploreOr[T any](object *simplejson.Json, path string, def T) (T, error) {
+	if object == nil {
+		return def, errors.New("parameter object is missing")
+	}
+	if path == "" {
+		return def, errors.New("parameter path is missing")
+	}
+	value := object
+	var exist bool
+	for _, key := range strings.Split(path, ".") {
+		if value, exist = value.CheckGet(key); !exist {
+			return def, nil
+		}
+	}
+	return resolveOr(value, exist, def)
+}
+
+func resolveOr[T any](value *simplejson.Json, exist bool, def T) (T, error) {
+	if !exist || value.Interface() == nil {
+		return def, nil
+	}
+	res, err := Resolve[T](value)
+	if err != nil {
+		return def, errors.WithMessage(err, "unable to resolve JSON value")
+	}
+	return res, nil
+}
@@ -0,0 +1,65 @@
+package simplejsonx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestExtractOr(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"port": 0, "host": null, "name": "svc"}`))
+	require.NoError(t, err)
+
+	port, err := simplejsonx.ExtractOr(object, "port", 8080)
+	require.NoError(t, err)
+	require.Equal(t, 0, port)
+
+	timeout, err := simplejsonx.ExtractOr(object, "timeout", 30)
+	require.NoError(t, err)
+	require.Equal(t, 30, timeout)
+
+	host, err := simplejsonx.ExtractOr(object, "host", "localhost")
+	require.NoError(t, err)
+	require.Equal(t, "localhost", host)
+
+	_, err = simplejsonx.ExtractOr(object, "name", 1)
+	require.Error(t, err)
+}
+
+func TestInspectOr(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"debug": false, "level": null}`))
+	require.NoError(t, err)
+
+	debug, err := simplejsonx.InspectOr(object, "debug", true)
+	require.NoError(t, err)
+	require.False(t, debug)
+
+	level, err := simplejsonx.InspectOr(object, "level", "info")
+	require.NoError(t, err)
+	require.Equal(t, "info", level)
+
+	_, err = simplejsonx.InspectOr(object, "debug", "x")
+	require.Error(t, err)
+}
+
+func TestExploreOr(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"server": {"port": 9090, "tls": null, "host": 1}}`))
+	require.NoError(t, err)
+
+	port, err := simplejsonx.ExploreOr(object, "server.port", 8080)
+	require.NoError(t, err)
+	require.Equal(t, 9090, port)
+
+	retries, err := simplejsonx.ExploreOr(object, "server.retry.max", 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, retries)
+
+	tls, err := simplejsonx.ExploreOr(object, "server.tls", true)
+	require.NoError(t, err)
+	require.True(t, tls)
+
+	host, err := simplejsonx.ExploreOr(object, "server.host", "localhost")
+	require.Error(t, err)
+	require.Equal(t, "localhost", host)
+}
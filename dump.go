@@ -0,0 +1,371 @@
+package simplejsonx
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// DumpConfig controls how Dump serializes JSON documents
+// Default settings match simplejson.Json MarshalJSON: compact, sorted keys, HTML escaped
+//
+// DumpConfig 控制 Dump 序列化 JSON 文档的方式
+// 默认设置与 simplejson.Json 的 MarshalJSON 一致：紧凑、键有序、转义 HTML
+type DumpConfig struct {
+	Indent          int  // spaces per nesting level, zero means compact // 每层缩进的空格数，0 表示紧凑输出
+	SortKeys        bool // write object keys in sorted order // 按排序顺序输出对象的键
+	EscapeHTML      bool // escape <, > and & as \u003c, \u003e and \u0026 // 将 <、> 和 & 转义为 \u003c、\u003e 和 \u0026
+	TrailingNewline bool // append newline after the document // 在文档末尾追加换行符
+	TruncateDepth   int  // replace containers deeper than this with placeholders, zero means unlimited // 将超过该深度的容器替换成占位符，0 表示不限制
+}
+
+// NewDumpConfig creates DumpConfig with default settings
+//
+// NewDumpConfig 创建默认设置的 DumpConfig
+func NewDumpConfig() *DumpConfig {
+	return &DumpConfig{
+		SortKeys:   true,
+		EscapeHTML: true,
+	}
+}
+
+// WithIndent sets number of spaces per nesting level
+//
+// WithIndent 设置每层缩进的空格数
+func (c *DumpConfig) WithIndent(indent int) *DumpConfig {
+	c.Indent = indent
+	return c
+}
+
+// WithSortedKeys sets whether object keys are written in sorted order
+//
+// WithSortedKeys 设置是否按排序顺序输出对象的键
+func (c *DumpConfig) WithSortedKeys(sortKeys bool) *DumpConfig {
+	c.SortKeys = sortKeys
+	return c
+}
+
+// WithEscapeHTML sets whether HTML characters in strings are escaped
+//
+// WithEscapeHTML 设置是否转义字符串中的 HTML 字符
+func (c *DumpConfig) WithEscapeHTML(escapeHTML bool) *DumpConfig {
+	c.EscapeHTML = escapeHTML
+	return c
+}
+
+// WithTrailingNewline appends newline after the document
+//
+// WithTrailingNewline 在文档末尾追加换行符
+func (c *DumpConfig) WithTrailingNewline() *DumpConfig {
+	c.TrailingNewline = true
+	return c
+}
+
+// WithTruncateDepth replaces containers nested deeper than depth with "{...}" or "[...]"
+// Useful when logging large documents, the output stays valid JSON
+//
+// WithTruncateDepth 将嵌套深度超过 depth 的容器替换成 "{...}" 或 "[...]"
+// 适合在日志中输出大文档，输出仍然是合法的 JSON
+func (c *DumpConfig) WithTruncateDepth(depth int) *DumpConfig {
+	c.TruncateDepth = depth
+	return c
+}
+
+// Dump serializes JSON document into bytes using default settings
+// Symmetric counterpart of Load
+//
+// Dump 使用默认设置将 JSON 文档序列化成字节
+// 是 Load 的对称操作
+func Dump(object *simplejson.Json) ([]byte, error) {
+	return DumpWith(object, nil)
+}
+
+// DumpWith serializes JSON document into bytes using given config
+// Nil config falls back to default settings
+//
+// DumpWith 使用给定配置将 JSON 文档序列化成字节
+// 配置为 nil 时使用默认设置
+func DumpWith(object *simplejson.Json, config *DumpConfig) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := DumpTo(&buffer, object, config); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// DumpTo serializes JSON document directly into writer using given config
+// Streams output through buffered writer, large documents are not buffered twice
+// Nil config falls back to default settings
+//
+// DumpTo 使用给定配置将 JSON 文档直接序列化到 writer 中
+// 通过缓冲写入器流式输出，大文档不会被重复缓冲
+// 配置为 nil 时使用默认设置
+func DumpTo(w io.Writer, object *simplejson.Json, config *DumpConfig) error {
+	if w == nil {
+		return errors.New("parameter writer is missing")
+	}
+	if object == nil {
+		return errors.New("parameter object is missing")
+	}
+	if config == nil {
+		config = NewDumpConfig()
+	}
+	writer := bufio.NewWriter(w)
+	d := &dumper{writer: writer, config: config}
+	if err := d.writeValue(object.Interface(), 0); err != nil {
+		return errors.WithMessage(err, "unable to dump JSON")
+	}
+	if config.TrailingNewline {
+		writer.WriteByte('\n')
+	}
+	if err := writer.Flush(); err != nil {
+		return errors.WithMessage(err, "unable to write JSON")
+	}
+	return nil
+}
+
+// dumpWriter is satisfied by both *bufio.Writer and *bytes.Buffer
+//
+// dumpWriter 同时由 *bufio.Writer 和 *bytes.Buffer 实现
+type dumpWriter interface {
+	io.Writer
+	io.ByteWriter
+	io.StringWriter
+}
+
+type dumper struct {
+	writer dumpWriter
+	config *DumpConfig
+}
+
+func (d *dumper) writeValue(value interface{}, depth int) error {
+	switch v := value.(type) {
+	case nil:
+		d.writer.WriteString("null")
+		return nil
+	case bool:
+		d.writer.WriteString(strconv.FormatBool(v))
+		return nil
+	case string:
+		writeJSONString(d.writer, v, d.config.EscapeHTML)
+		return nil
+	case json.Number:
+		if v == "" {
+			v = "0"
+		}
+		d.writer.WriteString(string(v))
+		return nil
+	case map[string]interface{}:
+		return d.writeObject(v, depth)
+	case []interface{}:
+		return d.writeArray(v, depth)
+	}
+	if number, ok, err := formatJSONNumber(value); ok {
+		if err != nil {
+			return err
+		}
+		d.writer.WriteString(number)
+		return nil
+	}
+	normalized, err := normalizeValue(value)
+	if err != nil {
+		return err
+	}
+	return d.writeValue(normalized, depth)
+}
+
+// formatJSONNumber formats native Go numbers the same way as encoding/json, float32 keeps its own precision
+// ok is false when value is not a native number, NaN and infinities give errors
+//
+// formatJSONNumber 按照 encoding/json 的方式格式化 Go 原生数字，float32 保持其自身精度
+// value 不是原生数字时 ok 为 false，NaN 和无穷大返回错误
+func formatJSONNumber(value interface{}) (res string, ok bool, err error) {
+	switch v := value.(type) {
+	case int:
+		return strconv.FormatInt(int64(v), 10), true, nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), true, nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), true, nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), true, nil
+	case int64:
+		return strconv.FormatInt(v, 10), true, nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), true, nil
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), true, nil
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), true, nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), true, nil
+	case uint64:
+		return strconv.FormatUint(v, 10), true, nil
+	case float32:
+		res, err := formatJSONFloat(float64(v), 32)
+		return res, true, err
+	case float64:
+		res, err := formatJSONFloat(v, 64)
+		return res, true, err
+	default:
+		return "", false, nil
+	}
+}
+
+func formatJSONFloat(number float64, bitSize int) (string, error) {
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return "", errors.Errorf("unsupported value: %s", strconv.FormatFloat(number, 'g', -1, bitSize))
+	}
+	format := byte('f')
+	if abs := math.Abs(number); abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) || bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	res := strconv.FormatFloat(number, format, -1, bitSize)
+	if format == 'e' {
+		// encoding/json writes "1e-7" where strconv writes "1e-07"
+		if n := len(res); n >= 4 && res[n-4] == 'e' && res[n-3] == '-' && res[n-2] == '0' {
+			res = res[:n-2] + res[n-1:]
+		}
+	}
+	return res, nil
+}
+
+func (d *dumper) writeObject(object map[string]interface{}, depth int) error {
+	if len(object) == 0 {
+		d.writer.WriteString("{}")
+		return nil
+	}
+	if d.config.TruncateDepth > 0 && depth >= d.config.TruncateDepth {
+		d.writer.WriteString(`"{...}"`)
+		return nil
+	}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	if d.config.SortKeys {
+		sort.Strings(keys)
+	}
+	d.writer.WriteByte('{')
+	for idx, key := range keys {
+		if idx > 0 {
+			d.writer.WriteByte(',')
+		}
+		d.writeNewline(depth + 1)
+		writeJSONString(d.writer, key, d.config.EscapeHTML)
+		d.writer.WriteByte(':')
+		if d.config.Indent > 0 {
+			d.writer.WriteByte(' ')
+		}
+		if err := d.writeValue(object[key], depth+1); err != nil {
+			return err
+		}
+	}
+	d.writeNewline(depth)
+	d.writer.WriteByte('}')
+	return nil
+}
+
+func (d *dumper) writeArray(elements []interface{}, depth int) error {
+	if len(elements) == 0 {
+		d.writer.WriteString("[]")
+		return nil
+	}
+	if d.config.TruncateDepth > 0 && depth >= d.config.TruncateDepth {
+		d.writer.WriteString(`"[...]"`)
+		return nil
+	}
+	d.writer.WriteByte('[')
+	for idx, elem := range elements {
+		if idx > 0 {
+			d.writer.WriteByte(',')
+		}
+		d.writeNewline(depth + 1)
+		if err := d.writeValue(elem, depth+1); err != nil {
+			return err
+		}
+	}
+	d.writeNewline(depth)
+	d.writer.WriteByte(']')
+	return nil
+}
+
+func (d *dumper) writeNewline(depth int) {
+	if d.config.Indent <= 0 {
+		return
+	}
+	d.writer.WriteByte('\n')
+	d.writer.WriteString(strings.Repeat(" ", d.config.Indent*depth))
+}
+
+// writeJSONString writes quoted string with the same escaping rules as encoding/json
+// Invalid UTF-8 bytes become U+FFFD, U+2028 and U+2029 are always escaped
+//
+// writeJSONString 使用与 encoding/json 相同的转义规则写入带引号的字符串
+// 非法 UTF-8 字节会被替换成 U+FFFD，U+2028 和 U+2029 总是被转义
+func writeJSONString(w dumpWriter, s string, escapeHTML bool) {
+	const hexDigits = "0123456789abcdef"
+	w.WriteByte('"')
+	start := 0
+	for idx := 0; idx < len(s); {
+		if c := s[idx]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && (!escapeHTML || (c != '<' && c != '>' && c != '&')) {
+				idx++
+				continue
+			}
+			w.WriteString(s[start:idx])
+			switch c {
+			case '"', '\\':
+				w.WriteByte('\\')
+				w.WriteByte(c)
+			case '\n':
+				w.WriteString(`\n`)
+			case '\r':
+				w.WriteString(`\r`)
+			case '\t':
+				w.WriteString(`\t`)
+			case '\b':
+				w.WriteString(`\b`)
+			case '\f':
+				w.WriteString(`\f`)
+			default:
+				w.WriteString(`\u00`)
+				w.WriteByte(hexDigits[c>>4])
+				w.WriteByte(hexDigits[c&0xF])
+			}
+			idx++
+			start = idx
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[idx:])
+		if r == utf8.RuneError && size == 1 {
+			w.WriteString(s[start:idx])
+			w.WriteString(`\ufffd`)
+			idx += size
+			start = idx
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			w.WriteString(s[start:idx])
+			w.WriteString(`\u202`)
+			w.WriteByte(hexDigits[r&0xF])
+			idx += size
+			start = idx
+			continue
+		}
+		idx += size
+	}
+	w.WriteString(s[start:])
+	w.WriteByte('"')
+}
@@ -0,0 +1,114 @@
+package simplejsonx_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestDump(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"name": "<yyle88>", "age": 18, "tags": ["a", "b"], "none": null}`))
+	require.NoError(t, err)
+
+	data, err := simplejsonx.Dump(object)
+	require.NoError(t, err)
+	t.Log(string(data))
+
+	expected, err := object.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(data))
+}
+
+func TestDump_MatchesMarshalJSON(t *testing.T) {
+	type Item struct {
+		Name string `json:"name"`
+	}
+	for _, value := range []interface{}{
+		map[string]interface{}{"f": float32(0.1), "g": float32(3.4e38), "h": float32(1e-7)},
+		map[string]interface{}{"small": 1e-7, "large": 1e21, "half": 0.5, "zero": -0.0},
+		[]interface{}{int8(-8), int16(16), int32(-32), uint(7), uint8(8), uint16(16), uint32(32)},
+		[]interface{}{uint64(math.MaxUint64), int64(math.MinInt64), uint(math.MaxUint)},
+		map[string]interface{}{"text": "a\bb\fc\u0001<&>\u2028"},
+		map[string]interface{}{"floats": []float32{0.1, 0.2}, "ints": map[string]int{"a": 1}, "items": []Item{{Name: "x"}}},
+	} {
+		object := simplejsonx.Wrap(value)
+
+		data, err := simplejsonx.Dump(object)
+		require.NoError(t, err)
+
+		expected, err := object.MarshalJSON()
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(data))
+	}
+
+	_, err := simplejsonx.Dump(simplejsonx.Wrap(map[string]interface{}{"nan": math.NaN()}))
+	require.Error(t, err)
+	t.Log(err)
+	require.NotContains(t, err.Error(), "canonical")
+}
+
+func TestDumpWith_Indent(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"b": {"c": [1, 2], "d": {}}, "a": "<x>"}`))
+	require.NoError(t, err)
+
+	config := simplejsonx.NewDumpConfig().WithIndent(2).WithEscapeHTML(false).WithTrailingNewline()
+	data, err := simplejsonx.DumpWith(object, config)
+	require.NoError(t, err)
+	t.Log(string(data))
+	require.Equal(t, `{
+  "a": "<x>",
+  "b": {
+    "c": [
+      1,
+      2
+    ],
+    "d": {}
+  }
+}
+`, string(data))
+}
+
+func TestDumpWith_TruncateDepth(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"a": {"b": {"c": 1}, "d": [1, [2]]}, "e": 1}`))
+	require.NoError(t, err)
+
+	data, err := simplejsonx.DumpWith(object, simplejsonx.NewDumpConfig().WithTruncateDepth(2))
+	require.NoError(t, err)
+	t.Log(string(data))
+	require.Equal(t, `{"a":{"b":"{...}","d":"[...]"},"e":1}`, string(data))
+
+	_, err = simplejsonx.Load(data)
+	require.NoError(t, err)
+}
+
+func TestDumpWith_WrappedValues(t *testing.T) {
+	type Item struct {
+		Name  string `json:"name"`
+		Price int    `json:"price"`
+	}
+	object := simplejsonx.Wrap(map[string]interface{}{
+		"items": []Item{{Name: "a", Price: 1}},
+		"rate":  0.5,
+		"count": 3,
+		"line":  "x\u2028y\n",
+	})
+
+	data, err := simplejsonx.Dump(object)
+	require.NoError(t, err)
+	t.Log(string(data))
+	require.Equal(t, `{"count":3,"items":[{"name":"a","price":1}],"line":"x\u2028y\n","rate":0.5}`, string(data))
+}
+
+func TestDumpTo(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`[1, "two", 3.3]`))
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, simplejsonx.DumpTo(&buffer, object, nil))
+	require.Equal(t, `[1,"two",3.3]`, buffer.String())
+
+	require.Error(t, simplejsonx.DumpTo(&buffer, nil, nil))
+}
@@ -0,0 +1,80 @@
+package simplejsonx
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// ErrStopIteration can be returned from Each and EachField callbacks to stop early without error
+//
+// ErrStopIteration 可由 Each 和 EachField 的回调返回，用于提前停止且不报错
+var ErrStopIteration = errors.New("stop iteration")
+
+// Each iterates the JSON array at the specified key, converting each element via Resolve
+// fn receives the element index, returning ErrStopIteration stops quietly, other errors are returned
+// Returns errors when key is missing, when value is not an array, when element conversion fails
+//
+// Each 遍历指定键处的 JSON 数组，通过 Resolve 转换每个元素
+// fn 接收元素下标，返回 ErrStopIteration 时静默停止，其他错误会直接返回
+// 当键缺失、值不是数组或元素转换失败时返回错误
+func Each[T any](object *simplejson.Json, key string, fn func(i int, v T) error) error {
+	if object == nil {
+		return errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return errors.New("parameter key is missing")
+	}
+	if fn == nil {
+		return errors.New("parameter fn is missing")
+	}
+	elements, err := object.Get(key).Array()
+	if err != nil {
+		return errors.WithMessage(err, "unable to get list")
+	}
+	for idx, elem := range List(elements) {
+		res, err := Resolve[T](elem)
+		if err != nil {
+			return errors.WithMessagef(err, "unable to resolve element %d", idx)
+		}
+		if err := fn(idx, res); err != nil {
+			if errors.Is(err, ErrStopIteration) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// EachField iterates the fields of JSON object in sorted key order, converting each value via Resolve
+// Returning ErrStopIteration from fn stops quietly, other errors are returned
+// Returns errors when value is not an object, when field conversion fails
+//
+// EachField 按键排序遍历 JSON 对象的字段，通过 Resolve 转换每个值
+// fn 返回 ErrStopIteration 时静默停止，其他错误会直接返回
+// 当值不是对象或字段转换失败时返回错误
+func EachField[T any](object *simplejson.Json, fn func(key string, v T) error) error {
+	if object == nil {
+		return errors.New("parameter object is missing")
+	}
+	if fn == nil {
+		return errors.New("parameter fn is missing")
+	}
+	fields, err := object.Map()
+	if err != nil {
+		return errors.WithMessage(err, "unable to get map")
+	}
+	for _, key := range sortedKeys(fields) {
+		res, err := Resolve[T](Wrap(fields[key]))
+		if err != nil {
+			return errors.WithMessagef(err, "unable to resolve field %q", key)
+		}
+		if err := fn(key, res); err != nil {
+			if errors.Is(err, ErrStopIteration) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package simplejsonx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestEach(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"prices": [10, 20, 30], "users": [{"name": "a"}, {"name": "b"}]}`))
+	require.NoError(t, err)
+
+	var sum int
+	var indexes []int
+	err = simplejsonx.Each(object, "prices", func(i int, v int) error {
+		indexes = append(indexes, i)
+		sum += v
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2}, indexes)
+	require.Equal(t, 60, sum)
+
+	var names []string
+	err = simplejsonx.Each(object, "users", func(i int, v *simplejson.Json) error {
+		name, err := simplejsonx.Extract[string](v, "name")
+		names = append(names, name)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestEach_Stop(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"prices": [10, 20, 30]}`))
+	require.NoError(t, err)
+
+	var count int
+	err = simplejsonx.Each(object, "prices", func(i int, v int) error {
+		count++
+		if i == 1 {
+			return simplejsonx.ErrStopIteration
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	failErr := errors.New("fail")
+	err = simplejsonx.Each(object, "prices", func(i int, v int) error {
+		return failErr
+	})
+	require.ErrorIs(t, err, failErr)
+}
+
+func TestEach_Errors(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"prices": [10, "x"], "name": "a"}`))
+	require.NoError(t, err)
+
+	err = simplejsonx.Each(object, "prices", func(i int, v int) error { return nil })
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "element 1")
+
+	err = simplejsonx.Each(object, "name", func(i int, v int) error { return nil })
+	require.Error(t, err)
+
+	err = simplejsonx.Each(object, "missing", func(i int, v int) error { return nil })
+	require.Error(t, err)
+}
+
+func TestEachField(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"c": 3, "a": 1, "b": 2}`))
+	require.NoError(t, err)
+
+	var keys []string
+	var values []int64
+	err = simplejsonx.EachField(object, func(key string, v int64) error {
+		keys = append(keys, key)
+		values = append(values, v)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, keys)
+	require.Equal(t, []int64{1, 2, 3}, values)
+
+	keys = nil
+	err = simplejsonx.EachField(object, func(key string, v int64) error {
+		keys = append(keys, key)
+		return simplejsonx.ErrStopIteration
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, keys)
+
+	err = simplejsonx.EachField(object, func(key string, v string) error { return nil })
+	require.Error(t, err)
+
+	err = simplejsonx.EachField(simplejsonx.Wrap([]interface{}{1}), func(key string, v int) error { return nil })
+	require.Error(t, err)
+}
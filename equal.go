@@ -0,0 +1,270 @@
+package simplejsonx
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/bitly/go-simplejson"
+)
+
+// EqualConfig controls how Equal and Contains compare JSON documents
+// Zero value compares arrays in order, matching encoding/json semantics
+//
+// EqualConfig 控制 Equal 和 Contains 比较 JSON 文档的方式
+// 零值按顺序比较数组，与 encoding/json 语义一致
+type EqualConfig struct {
+	UnorderedArrays bool // compare arrays as multisets // 将数组视为多重集合进行比较
+}
+
+// NewEqualConfig creates EqualConfig with default settings
+//
+// NewEqualConfig 创建默认设置的 EqualConfig
+func NewEqualConfig() *EqualConfig {
+	return &EqualConfig{}
+}
+
+// WithUnorderedArrays makes array comparison ignore element order
+//
+// WithUnorderedArrays 使数组比较忽略元素顺序
+func (c *EqualConfig) WithUnorderedArrays() *EqualConfig {
+	c.UnorderedArrays = true
+	return c
+}
+
+// Equal reports whether two JSON documents hold the same value
+// Numbers compare by value across json.Number, float64 and int representations
+// Arrays compare in order, use EqualWith to compare them as multisets
+//
+// Equal 判断两个 JSON 文档是否具有相同的值
+// 数字按数值比较，兼容 json.Number、float64 和 int 等表示形式
+// 数组按顺序比较，使用 EqualWith 可按多重集合比较
+func Equal(a, b *simplejson.Json) bool {
+	return EqualWith(a, b, nil)
+}
+
+// EqualWith reports whether two JSON documents hold the same value using given config
+// Nil config falls back to default settings
+//
+// EqualWith 使用给定配置判断两个 JSON 文档是否具有相同的值
+// 配置为 nil 时使用默认设置
+func EqualWith(a, b *simplejson.Json, config *EqualConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return matchValues(a.Interface(), b.Interface(), newMatcher(config, false))
+}
+
+// Contains reports whether super document includes all fields of sub document
+// Objects in super may carry extra keys, arrays match element by element
+// Numbers compare by value the same as Equal
+//
+// Contains 判断 super 文档是否包含 sub 文档的全部字段
+// super 中的对象可以包含额外的键，数组逐个元素匹配
+// 数字与 Equal 一样按数值比较
+func Contains(super, sub *simplejson.Json) bool {
+	return ContainsWith(super, sub, nil)
+}
+
+// ContainsWith reports whether super document includes sub document using given config
+// With unordered arrays each expected element must match a distinct actual element
+//
+// ContainsWith 使用给定配置判断 super 文档是否包含 sub 文档
+// 无序数组模式下每个期望元素都必须匹配一个不同的实际元素
+func ContainsWith(super, sub *simplejson.Json, config *EqualConfig) bool {
+	if super == nil || sub == nil {
+		return super == sub
+	}
+	return matchValues(super.Interface(), sub.Interface(), newMatcher(config, true))
+}
+
+type matcher struct {
+	unordered bool
+	subset    bool
+}
+
+func newMatcher(config *EqualConfig, subset bool) *matcher {
+	if config == nil {
+		config = NewEqualConfig()
+	}
+	return &matcher{unordered: config.UnorderedArrays, subset: subset}
+}
+
+// matchValues compares actual against expected, in subset mode actual may hold more
+//
+// matchValues 比较实际值与期望值，子集模式下实际值可以包含更多内容
+func matchValues(actual, expected interface{}, m *matcher) bool {
+	if ra, ok := numberToRat(actual); ok {
+		rb, ok := numberToRat(expected)
+		return ok && ra.Cmp(rb) == 0
+	}
+	actual = toGeneric(actual)
+	expected = toGeneric(expected)
+	switch va := actual.(type) {
+	case nil:
+		return expected == nil
+	case map[string]interface{}:
+		vb, ok := expected.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if !m.subset && len(va) != len(vb) {
+			return false
+		}
+		for key, elem := range vb {
+			value, exist := va[key]
+			if !exist || !matchValues(value, elem, m) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		vb, ok := expected.([]interface{})
+		if !ok {
+			return false
+		}
+		if m.unordered {
+			if !m.subset && len(va) != len(vb) {
+				return false
+			}
+			return matchUnordered(va, vb, m)
+		}
+		if len(va) != len(vb) {
+			return false
+		}
+		for idx := range va {
+			if !matchValues(va[idx], vb[idx], m) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(actual, expected)
+	}
+}
+
+// matchUnordered pairs each expected element with a distinct actual element
+// Uses augmenting paths so that greedy choices never hide a valid pairing
+//
+// matchUnordered 为每个期望元素匹配一个不同的实际元素
+// 使用增广路径，避免贪心选择错过有效的匹配
+func matchUnordered(actual, expected []interface{}, m *matcher) bool {
+	if len(expected) > len(actual) {
+		return false
+	}
+	edges := make([][]int, len(expected))
+	for i, elem := range expected {
+		for j, value := range actual {
+			if matchValues(value, elem, m) {
+				edges[i] = append(edges[i], j)
+			}
+		}
+		if len(edges[i]) == 0 {
+			return false
+		}
+	}
+	owner := make([]int, len(actual))
+	for j := range owner {
+		owner[j] = -1
+	}
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for _, j := range edges[i] {
+			if seen[j] {
+				continue
+			}
+			seen[j] = true
+			if owner[j] < 0 || augment(owner[j], seen) {
+				owner[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range expected {
+		if !augment(i, make([]bool, len(actual))) {
+			return false
+		}
+	}
+	return true
+}
+
+// numberToRat converts any numeric representation into exact rational value
+// Floats go through shortest decimal form so 0.1 equals json.Number("0.1")
+//
+// numberToRat 将任意数字表示转换成精确的有理数
+// 浮点数先转换成最短十进制形式，使 0.1 等于 json.Number("0.1")
+func numberToRat(v interface{}) (*big.Rat, bool) {
+	switch x := v.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(string(x))
+	case float64:
+		return floatToRat(x, 64)
+	case float32:
+		return floatToRat(float64(x), 32)
+	case int:
+		return new(big.Rat).SetInt64(int64(x)), true
+	case int8:
+		return new(big.Rat).SetInt64(int64(x)), true
+	case int16:
+		return new(big.Rat).SetInt64(int64(x)), true
+	case int32:
+		return new(big.Rat).SetInt64(int64(x)), true
+	case int64:
+		return new(big.Rat).SetInt64(x), true
+	case uint:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(uint64(x))), true
+	case uint8:
+		return new(big.Rat).SetInt64(int64(x)), true
+	case uint16:
+		return new(big.Rat).SetInt64(int64(x)), true
+	case uint32:
+		return new(big.Rat).SetInt64(int64(x)), true
+	case uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(x)), true
+	default:
+		return nil, false
+	}
+}
+
+func floatToRat(x float64, bitSize int) (*big.Rat, bool) {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(strconv.FormatFloat(x, 'g', -1, bitSize))
+}
+
+// toGeneric converts typed maps and slices into generic JSON containers
+// Values wrapped from Go code like []string become []interface{} to compare with loaded data
+//
+// toGeneric 将类型化的映射和切片转换成通用 JSON 容器
+// 从 Go 代码包装的值（如 []string）会转换成 []interface{}，以便与加载的数据比较
+func toGeneric(v interface{}) interface{} {
+	switch v.(type) {
+	case nil, map[string]interface{}, []interface{}, string, bool, []byte:
+		return v
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return v
+		}
+		res := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			res[iter.Key().String()] = iter.Value().Interface()
+		}
+		return res
+	case reflect.Slice, reflect.Array:
+		res := make([]interface{}, rv.Len())
+		for idx := range res {
+			res[idx] = rv.Index(idx).Interface()
+		}
+		return res
+	default:
+		return v
+	}
+}
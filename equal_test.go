@@ -0,0 +1,80 @@
+package simplejsonx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestEqual(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"name": "yyle88", "age": 18, "rate": 0.1, "tags": ["a", "b"]}`))
+	require.NoError(t, err)
+
+	value := simplejsonx.Wrap(map[string]interface{}{
+		"name": "yyle88",
+		"age":  18,
+		"rate": 0.1,
+		"tags": []string{"a", "b"},
+	})
+	require.True(t, simplejsonx.Equal(object, value))
+	require.True(t, simplejsonx.Equal(value, object))
+}
+
+func TestEqual_Mismatch(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"name": "yyle88", "age": 18}`))
+	require.NoError(t, err)
+
+	require.False(t, simplejsonx.Equal(object, simplejsonx.Wrap(map[string]interface{}{"name": "yyle88", "age": 19})))
+	require.False(t, simplejsonx.Equal(object, simplejsonx.Wrap(map[string]interface{}{"name": "yyle88"})))
+	require.False(t, simplejsonx.Equal(object, simplejsonx.Wrap(map[string]interface{}{"name": "yyle88", "age": "18"})))
+	require.False(t, simplejsonx.Equal(object, nil))
+}
+
+func TestEqualWith_UnorderedArrays(t *testing.T) {
+	a, err := simplejsonx.Load([]byte(`[1, 2, 2, 3]`))
+	require.NoError(t, err)
+	b, err := simplejsonx.Load([]byte(`[2, 3, 2, 1.0]`))
+	require.NoError(t, err)
+
+	require.False(t, simplejsonx.Equal(a, b))
+	require.True(t, simplejsonx.EqualWith(a, b, simplejsonx.NewEqualConfig().WithUnorderedArrays()))
+
+	c, err := simplejsonx.Load([]byte(`[2, 3, 3, 1]`))
+	require.NoError(t, err)
+	require.False(t, simplejsonx.EqualWith(a, c, simplejsonx.NewEqualConfig().WithUnorderedArrays()))
+}
+
+func TestContains(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{
+		"code": 0,
+		"data": {"id": 100, "name": "abc", "extra": {"trace": "xyz"}},
+		"items": [{"id": 1, "price": 9.5}, {"id": 2, "price": 10}]
+	}`))
+	require.NoError(t, err)
+
+	expected := simplejsonx.Wrap(map[string]interface{}{
+		"code": 0,
+		"data": map[string]interface{}{"id": 100},
+		"items": []interface{}{
+			map[string]interface{}{"id": 1},
+			map[string]interface{}{"price": 10.0},
+		},
+	})
+	require.True(t, simplejsonx.Contains(object, expected))
+	require.False(t, simplejsonx.Contains(expected, object))
+
+	missing := simplejsonx.Wrap(map[string]interface{}{"data": map[string]interface{}{"uid": 100}})
+	require.False(t, simplejsonx.Contains(object, missing))
+}
+
+func TestContainsWith_UnorderedArrays(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"items": [{"id": 1, "ok": true}, {"id": 2, "ok": false}, {"id": 3}]}`))
+	require.NoError(t, err)
+
+	expected, err := simplejsonx.Load([]byte(`{"items": [{"id": 2}, {"ok": true}]}`))
+	require.NoError(t, err)
+
+	require.False(t, simplejsonx.Contains(object, expected))
+	require.True(t, simplejsonx.ContainsWith(object, expected, simplejsonx.NewEqualConfig().WithUnorderedArrays()))
+}
@@ -0,0 +1,210 @@
+package jsonparse
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// skipSpace5 consumes whitespace JSON5 accepts beyond JSON: \v, \f, BOM and Unicode space separators
+//
+// skipSpace5 消费 JSON5 在 JSON 之外接受的空白字符：\v、\f、BOM 和 Unicode 空格分隔符
+func (p *parser) skipSpace5() bool {
+	c := p.data[p.pos]
+	if c == '\v' || c == '\f' {
+		p.pos++
+		return true
+	}
+	if c < utf8.RuneSelf {
+		return false
+	}
+	r, size := utf8.DecodeRune(p.data[p.pos:])
+	if r == '\uFEFF' || r == '\u2028' || r == '\u2029' || unicode.Is(unicode.Zs, r) {
+		p.pos += size
+		return true
+	}
+	return false
+}
+
+// parseValue5 parses values only JSON5 allows, ok is false when JSON grammar applies
+//
+// parseValue5 解析仅 JSON5 允许的值，当适用 JSON 语法时 ok 为 false
+func (p *parser) parseValue5() (value interface{}, ok bool, err error) {
+	switch c := p.data[p.pos]; {
+	case c == '\'':
+		res, err := p.parseString()
+		return res, true, err
+	case c == '+' || c == '-' || c == '.' || c == 'I' || c == 'N' || (c >= '0' && c <= '9'):
+		res, err := p.parseNumber5()
+		return res, true, err
+	default:
+		return nil, false, nil
+	}
+}
+
+// parseNumber5 parses JSON5 numbers into json.Number, or float64 for Infinity and NaN
+// Hexadecimal, leading plus and bare decimal points are normalized into JSON number syntax
+//
+// parseNumber5 将 JSON5 数字解析成 json.Number，Infinity 和 NaN 解析成 float64
+// 十六进制、前导加号和省略整数或小数部分的写法都会规范化为 JSON 数字语法
+func (p *parser) parseNumber5() (interface{}, error) {
+	negative := false
+	if c := p.data[p.pos]; c == '+' || c == '-' {
+		negative = c == '-'
+		p.pos++
+	}
+	switch {
+	case p.hasPrefix("Infinity"):
+		p.pos += len("Infinity")
+		if negative {
+			return math.Inf(-1), nil
+		}
+		return math.Inf(1), nil
+	case p.hasPrefix("NaN"):
+		p.pos += len("NaN")
+		return math.NaN(), nil
+	case p.hasPrefix("0x") || p.hasPrefix("0X"):
+		p.pos += 2
+		start := p.pos
+		for p.pos < len(p.data) && isHexDigit(p.data[p.pos]) {
+			p.pos++
+		}
+		number, ok := new(big.Int).SetString(string(p.data[start:p.pos]), 16)
+		if !ok {
+			return nil, p.syntaxError("invalid hexadecimal number")
+		}
+		if negative {
+			number.Neg(number)
+		}
+		return json.Number(number.String()), nil
+	}
+	intStart := p.pos
+	p.skipDigits()
+	intPart := string(p.data[intStart:p.pos])
+	if len(intPart) > 1 && intPart[0] == '0' {
+		return nil, p.syntaxErrorAt(intStart, "invalid number, leading zero")
+	}
+	var fracPart string
+	if p.pos < len(p.data) && p.data[p.pos] == '.' {
+		p.pos++
+		fracStart := p.pos
+		p.skipDigits()
+		fracPart = string(p.data[fracStart:p.pos])
+	}
+	if intPart == "" && fracPart == "" {
+		return nil, p.syntaxError("invalid number, expected digit")
+	}
+	var expPart string
+	if p.pos < len(p.data) && (p.data[p.pos] == 'e' || p.data[p.pos] == 'E') {
+		expStart := p.pos
+		p.pos++
+		if p.pos < len(p.data) && (p.data[p.pos] == '+' || p.data[p.pos] == '-') {
+			p.pos++
+		}
+		if !p.skipDigits() {
+			return nil, p.syntaxError("invalid number, expected digit in exponent")
+		}
+		expPart = string(p.data[expStart:p.pos])
+	}
+	res := intPart
+	if res == "" {
+		res = "0"
+	}
+	if negative {
+		res = "-" + res
+	}
+	if fracPart != "" {
+		res += "." + fracPart
+	}
+	return json.Number(res + expPart), nil
+}
+
+// parseKey5 parses single-quoted keys and unquoted ECMAScript identifier keys
+//
+// parseKey5 解析单引号键和不带引号的 ECMAScript 标识符键
+func (p *parser) parseKey5() (string, error) {
+	if p.pos >= len(p.data) {
+		return "", p.syntaxError("unexpected end of JSON input")
+	}
+	if p.data[p.pos] == '\'' {
+		return p.parseString()
+	}
+	start := p.pos
+	for p.pos < len(p.data) {
+		r, size := utf8.DecodeRune(p.data[p.pos:])
+		if !(r == '$' || r == '_' || unicode.IsLetter(r) || (p.pos > start && isIdentifierPart(r))) {
+			break
+		}
+		p.pos += size
+	}
+	if p.pos == start {
+		return "", p.syntaxError("expected string or identifier for object key")
+	}
+	key := string(p.data[start:p.pos])
+	if err := p.checkStringLength(len(key)); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func isIdentifierPart(r rune) bool {
+	return unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc, unicode.Pc) || r == '\u200C' || r == '\u200D'
+}
+
+// parseEscape5 handles JSON5 escapes: \', \v, \0, \xHH, line continuations and identity escapes
+//
+// parseEscape5 处理 JSON5 转义：\'、\v、\0、\xHH、续行以及字符自身的转义
+func (p *parser) parseEscape5(buffer []byte) ([]byte, error) {
+	if p.pos+1 >= len(p.data) {
+		return nil, p.syntaxError("unexpected end of JSON input in string escape")
+	}
+	switch c := p.data[p.pos+1]; {
+	case c == 'b' || c == 'f' || c == 'n' || c == 'r' || c == 't' || c == 'u' || c == '"' || c == '\\' || c == '/':
+		return p.parseEscape(buffer)
+	case c == '\'':
+		p.pos += 2
+		return append(buffer, '\''), nil
+	case c == 'v':
+		p.pos += 2
+		return append(buffer, '\v'), nil
+	case c == '0' && !(p.pos+2 < len(p.data) && p.data[p.pos+2] >= '0' && p.data[p.pos+2] <= '9'):
+		p.pos += 2
+		return append(buffer, 0), nil
+	case c >= '0' && c <= '9':
+		return nil, p.syntaxError("invalid digit escape in string")
+	case c == 'x':
+		if p.pos+4 > len(p.data) || !isHexDigit(p.data[p.pos+2]) || !isHexDigit(p.data[p.pos+3]) {
+			return nil, p.syntaxError("invalid hexadecimal escape in string")
+		}
+		value, _ := strconv.ParseUint(string(p.data[p.pos+2:p.pos+4]), 16, 8)
+		p.pos += 4
+		return utf8.AppendRune(buffer, rune(value)), nil
+	case c == '\n':
+		p.pos += 2 // line continuation
+		return buffer, nil
+	case c == '\r':
+		p.pos += 2
+		if p.pos < len(p.data) && p.data[p.pos] == '\n' {
+			p.pos++
+		}
+		return buffer, nil
+	default:
+		r, size := utf8.DecodeRune(p.data[p.pos+1:])
+		p.pos += 1 + size
+		if r == '\u2028' || r == '\u2029' {
+			return buffer, nil // line continuation
+		}
+		return utf8.AppendRune(buffer, r), nil
+	}
+}
+
+func (p *parser) hasPrefix(prefix string) bool {
+	return len(p.data)-p.pos >= len(prefix) && string(p.data[p.pos:p.pos+len(prefix)]) == prefix
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
@@ -0,0 +1,25 @@
+package jsonparse_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx/internal/jsonparse"
+)
+
+func TestParse_JSON5Escapes(t *testing.T) {
+	config := &jsonparse.Config{Comments: true, TrailingCommas: true, JSON5: true}
+
+	res, err := jsonparse.Parse([]byte(`['\x41\'\v\0\q', "tab	inside"]`), config)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"A'\v\x00q", "tab\tinside"}, res)
+}
+
+func TestParse_JSON5Numbers(t *testing.T) {
+	config := &jsonparse.Config{JSON5: true}
+
+	res, err := jsonparse.Parse([]byte(`[+.5, -5., 0XFF, 1e+2, +0]`), config)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{json.Number("0.5"), json.Number("-5"), json.Number("255"), json.Number("1e+2"), json.Number("0")}, res)
+}
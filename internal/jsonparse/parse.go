@@ -0,0 +1,618 @@
+// Package jsonparse provides a configurable JSON parser for simplejsonx loading functions.
+// Produces the same generic tree as encoding/json with UseNumber while enforcing limits.
+//
+// jsonparse 包为 simplejsonx 的加载函数提供可配置的 JSON 解析器
+// 生成与启用 UseNumber 的 encoding/json 相同的通用树，同时强制执行各项限制
+package jsonparse
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// ErrLimitExceeded is the sentinel matched by every LimitError via errors.Is
+//
+// ErrLimitExceeded 是所有 LimitError 通过 errors.Is 匹配的哨兵错误
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// LimitError reports input that exceeds one of the configured limits
+//
+// LimitError 表示输入超过了某项配置的限制
+type LimitError struct {
+	Limit  string // name of the exceeded limit // 被超过的限制名称
+	Max    int64  // configured maximum // 配置的最大值
+	Offset int64  // byte offset where the limit was exceeded // 超过限制时的字节偏移量
+}
+
+func (e *LimitError) Error() string {
+	return "limit exceeded: " + e.Limit + " over " + strconv.FormatInt(e.Max, 10) + " at offset " + strconv.FormatInt(e.Offset, 10)
+}
+
+// Unwrap makes errors.Is(err, ErrLimitExceeded) report true
+//
+// Unwrap 使 errors.Is(err, ErrLimitExceeded) 返回 true
+func (e *LimitError) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+// SyntaxError reports malformed input at the given byte offset, line and column
+//
+// SyntaxError 表示在指定字节偏移、行和列处的输入格式错误
+type SyntaxError struct {
+	Reason  string // description of the problem // 问题描述
+	Offset  int64  // byte offset of the problem // 问题所在的字节偏移量
+	Line    int    // 1-based line number // 从 1 开始的行号
+	Column  int    // 1-based column counted in characters // 从 1 开始按字符计数的列号
+	Snippet string // surrounding text on the same line // 同一行中的上下文文本
+}
+
+func (e *SyntaxError) Error() string {
+	message := e.Reason + " at line " + strconv.Itoa(e.Line) + ", column " + strconv.Itoa(e.Column) + " (offset " + strconv.FormatInt(e.Offset, 10) + ")"
+	if e.Snippet != "" {
+		message += " near " + strconv.Quote(e.Snippet)
+	}
+	return message
+}
+
+// NewSyntaxError creates SyntaxError at offset with line, column and snippet filled from data
+//
+// NewSyntaxError 在指定偏移处创建 SyntaxError，并根据数据填充行号、列号和上下文片段
+func NewSyntaxError(data []byte, offset int, reason string) *SyntaxError {
+	line, column := Position(data, offset)
+	return &SyntaxError{Reason: reason, Offset: int64(offset), Line: line, Column: column, Snippet: Snippet(data, offset)}
+}
+
+// Snippet returns up to 20 characters on each side of offset within its line
+//
+// Snippet 返回偏移量所在行中前后各最多 20 个字符的文本
+func Snippet(data []byte, offset int) string {
+	const radius = 20
+	if offset > len(data) {
+		offset = len(data)
+	}
+	start := offset
+	for count := 0; start > 0 && data[start-1] != '\n' && count < radius; count++ {
+		_, size := utf8.DecodeLastRune(data[:start])
+		start -= size
+	}
+	end := offset
+	for count := 0; end < len(data) && data[end] != '\n' && data[end] != '\r' && count < radius; count++ {
+		_, size := utf8.DecodeRune(data[end:])
+		end += size
+	}
+	return string(data[start:end])
+}
+
+// Position converts byte offset into 1-based line and column counted in characters
+//
+// Position 将字节偏移量转换成从 1 开始的行号和按字符计数的列号
+func Position(data []byte, offset int) (line int, column int) {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	line, lineStart := 1, 0
+	for idx := 0; idx < offset; idx++ {
+		if data[idx] == '\n' {
+			line++
+			lineStart = idx + 1
+		}
+	}
+	return line, utf8.RuneCount(data[lineStart:offset]) + 1
+}
+
+// DefaultMaxDepth caps nesting when MaxDepth is zero, matching encoding/json, so deep input cannot exhaust the stack
+//
+// DefaultMaxDepth 在 MaxDepth 为零时限制嵌套深度，与 encoding/json 一致，避免深层输入耗尽栈空间
+const DefaultMaxDepth = 10000
+
+// Config holds parser limits, zero values mean unlimited except depth which falls back to DefaultMaxDepth
+//
+// Config 保存解析器限制，零值表示不限制，但深度会回退到 DefaultMaxDepth
+type Config struct {
+	MaxDepth        int // maximum nesting depth of arrays and objects, zero uses DefaultMaxDepth // 数组和对象的最大嵌套深度，零值使用 DefaultMaxDepth
+	MaxArrayLength  int // maximum number of elements in one array // 单个数组的最大元素数量
+	MaxObjectKeys   int // maximum number of keys in one object // 单个对象的最大键数量
+	MaxStringLength int // maximum decoded byte length of one string or key // 单个字符串或键解码后的最大字节长度
+
+	Strict         bool // reject duplicate keys, invalid UTF-8, lone surrogates and trailing data // 拒绝重复键、非法 UTF-8、孤立代理项和尾随数据
+	NoTrailingData bool // reject data after the top-level value, implied by Strict // 拒绝顶层值之后的数据，Strict 时自动启用
+
+	Comments       bool // accept // line and /* block */ comments // 接受 // 行注释和 /* 块注释 */
+	TrailingCommas bool // accept comma before closing bracket // 接受右括号前的逗号
+	JSON5          bool // accept JSON5 keys, strings, numbers and whitespace // 接受 JSON5 的键、字符串、数字和空白字符
+
+	OnContainer func(positions *Positions) // called with offsets of each parsed object and array // 每解析完一个对象或数组时携带偏移量回调
+}
+
+// Positions records byte offsets of one parsed container and its members
+//
+// Positions 记录一个已解析容器及其成员的字节偏移量
+type Positions struct {
+	Container interface{}    // the map[string]interface{} or []interface{} value // 对应的 map[string]interface{} 或 []interface{} 值
+	Offset    int            // offset of the opening bracket // 起始括号的偏移量
+	Keys      map[string]int // offsets of object member values // 对象成员值的偏移量
+	Elems     []int          // offsets of array elements // 数组元素的偏移量
+}
+
+// Parse decodes data into generic tree of map[string]interface{}, []interface{},
+// json.Number, string, bool and nil values
+//
+// Parse 将数据解码成由 map[string]interface{}、[]interface{}、
+// json.Number、string、bool 和 nil 组成的通用树
+func Parse(data []byte, config *Config) (interface{}, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	p := &parser{data: data, config: config}
+	p.skipSpace()
+	if p.pos >= len(p.data) {
+		return nil, p.syntaxError("unexpected end of JSON input")
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if config.Strict || config.NoTrailingData || p.unclosed != 0 {
+		if p.pos < len(p.data) {
+			return nil, p.syntaxError("invalid character " + strconv.QuoteRune(rune(p.data[p.pos])) + " after top-level value")
+		}
+	}
+	return value, nil
+}
+
+type parser struct {
+	data     []byte
+	pos      int
+	depth    int
+	config   *Config
+	unclosed int // offset+1 of unterminated block comment, zero when none // 未闭合块注释的偏移量加一，没有时为零
+}
+
+func (p *parser) syntaxError(reason string) error {
+	return p.syntaxErrorAt(p.pos, reason)
+}
+
+func (p *parser) syntaxErrorAt(offset int, reason string) error {
+	if offset == p.unclosed-1 {
+		reason = "unterminated block comment"
+	}
+	return NewSyntaxError(p.data, offset, reason)
+}
+
+func (p *parser) limitError(limit string, max int) error {
+	return &LimitError{Limit: limit, Max: int64(max), Offset: int64(p.pos)}
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.data) {
+		switch p.data[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		case '/':
+			if !p.config.Comments || !p.skipComment() {
+				return
+			}
+		default:
+			if !p.config.JSON5 || !p.skipSpace5() {
+				return
+			}
+		}
+	}
+}
+
+// skipComment consumes comment at current position
+// Unterminated block comments are left in place and remembered, so the error is reported at their opening
+//
+// skipComment 消费当前位置的注释
+// 未闭合的块注释保持原位并被记录，以便在其起始位置报告错误
+func (p *parser) skipComment() bool {
+	if p.pos+1 >= len(p.data) {
+		return false
+	}
+	switch p.data[p.pos+1] {
+	case '/':
+		p.pos += 2
+		for p.pos < len(p.data) && p.data[p.pos] != '\n' {
+			p.pos++
+		}
+		return true
+	case '*':
+		end := bytes.Index(p.data[p.pos+2:], []byte("*/"))
+		if end < 0 {
+			p.unclosed = p.pos + 1
+			return false
+		}
+		p.pos += 2 + end + 2
+		return true
+	default:
+		return false
+	}
+}
+
+// closeAfterComma consumes closing bracket that directly follows a comma when trailing commas are accepted
+//
+// closeAfterComma 在接受尾随逗号时，消费紧跟在逗号之后的右括号
+func (p *parser) closeAfterComma(closing byte) bool {
+	if !p.config.TrailingCommas {
+		return false
+	}
+	p.skipSpace()
+	if p.pos < len(p.data) && p.data[p.pos] == closing {
+		p.pos++
+		p.depth--
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	if p.pos >= len(p.data) {
+		return nil, p.syntaxError("unexpected end of JSON input")
+	}
+	if p.config.JSON5 {
+		if value, ok, err := p.parseValue5(); ok {
+			return value, err
+		}
+	}
+	switch c := p.data[p.pos]; {
+	case c == '{':
+		return p.parseObject()
+	case c == '[':
+		return p.parseArray()
+	case c == '"':
+		return p.parseString()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case c == 't':
+		return true, p.parseLiteral("true")
+	case c == 'f':
+		return false, p.parseLiteral("false")
+	case c == 'n':
+		return nil, p.parseLiteral("null")
+	default:
+		return nil, p.syntaxError("invalid character " + strconv.QuoteRune(rune(c)) + " looking for beginning of value")
+	}
+}
+
+func (p *parser) parseLiteral(literal string) error {
+	if len(p.data)-p.pos < len(literal) || string(p.data[p.pos:p.pos+len(literal)]) != literal {
+		return p.syntaxError("invalid literal, expected " + literal)
+	}
+	p.pos += len(literal)
+	return nil
+}
+
+func (p *parser) enter() error {
+	p.depth++
+	maxDepth := p.config.MaxDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	if p.depth > maxDepth {
+		return p.limitError("depth", maxDepth)
+	}
+	return nil
+}
+
+func (p *parser) parseObject() (interface{}, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	offset := p.pos
+	p.pos++ // '{'
+	object := make(map[string]interface{})
+	var keys map[string]int
+	if p.config.OnContainer != nil {
+		keys = make(map[string]int)
+		defer func() {
+			p.config.OnContainer(&Positions{Container: object, Offset: offset, Keys: keys})
+		}()
+	}
+	p.skipSpace()
+	if p.pos < len(p.data) && p.data[p.pos] == '}' {
+		p.pos++
+		p.depth--
+		return object, nil
+	}
+	for count := 1; ; count++ {
+		if p.config.MaxObjectKeys > 0 && count > p.config.MaxObjectKeys {
+			return nil, p.limitError("object keys", p.config.MaxObjectKeys)
+		}
+		p.skipSpace()
+		keyOffset := p.pos
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		if _, exist := object[key]; exist && p.config.Strict {
+			return nil, p.syntaxErrorAt(keyOffset, "duplicate key "+strconv.Quote(key))
+		}
+		p.skipSpace()
+		if p.pos >= len(p.data) || p.data[p.pos] != ':' {
+			return nil, p.syntaxError("expected ':' after object key")
+		}
+		p.pos++
+		p.skipSpace()
+		if keys != nil {
+			keys[key] = p.pos
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		object[key] = value
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, p.syntaxError("unexpected end of JSON input")
+		}
+		switch p.data[p.pos] {
+		case ',':
+			p.pos++
+			if p.closeAfterComma('}') {
+				return object, nil
+			}
+		case '}':
+			p.pos++
+			p.depth--
+			return object, nil
+		default:
+			return nil, p.syntaxError("expected ',' or '}' after object value")
+		}
+	}
+}
+
+func (p *parser) parseKey() (string, error) {
+	if p.pos < len(p.data) && p.data[p.pos] == '"' {
+		return p.parseString()
+	}
+	if p.config.JSON5 {
+		return p.parseKey5()
+	}
+	return "", p.syntaxError("expected string for object key")
+}
+
+func (p *parser) parseArray() (interface{}, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	offset := p.pos
+	p.pos++ // '['
+	array := make([]interface{}, 0)
+	var elems []int
+	if p.config.OnContainer != nil {
+		defer func() {
+			p.config.OnContainer(&Positions{Container: array, Offset: offset, Elems: elems})
+		}()
+	}
+	p.skipSpace()
+	if p.pos < len(p.data) && p.data[p.pos] == ']' {
+		p.pos++
+		p.depth--
+		return array, nil
+	}
+	for {
+		if p.config.MaxArrayLength > 0 && len(array) >= p.config.MaxArrayLength {
+			return nil, p.limitError("array length", p.config.MaxArrayLength)
+		}
+		p.skipSpace()
+		if p.config.OnContainer != nil {
+			elems = append(elems, p.pos)
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, value)
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, p.syntaxError("unexpected end of JSON input")
+		}
+		switch p.data[p.pos] {
+		case ',':
+			p.pos++
+			if p.closeAfterComma(']') {
+				return array, nil
+			}
+		case ']':
+			p.pos++
+			p.depth--
+			return array, nil
+		default:
+			return nil, p.syntaxError("expected ',' or ']' after array element")
+		}
+	}
+}
+
+func (p *parser) parseNumber() (interface{}, error) {
+	start := p.pos
+	if p.data[p.pos] == '-' {
+		p.pos++
+	}
+	switch {
+	case p.pos < len(p.data) && p.data[p.pos] == '0':
+		p.pos++
+	case p.pos < len(p.data) && p.data[p.pos] >= '1' && p.data[p.pos] <= '9':
+		p.skipDigits()
+	default:
+		return nil, p.syntaxError("invalid number, expected digit")
+	}
+	if p.pos < len(p.data) && p.data[p.pos] == '.' {
+		p.pos++
+		if !p.skipDigits() {
+			return nil, p.syntaxError("invalid number, expected digit after decimal point")
+		}
+	}
+	if p.pos < len(p.data) && (p.data[p.pos] == 'e' || p.data[p.pos] == 'E') {
+		p.pos++
+		if p.pos < len(p.data) && (p.data[p.pos] == '+' || p.data[p.pos] == '-') {
+			p.pos++
+		}
+		if !p.skipDigits() {
+			return nil, p.syntaxError("invalid number, expected digit in exponent")
+		}
+	}
+	return json.Number(p.data[start:p.pos]), nil
+}
+
+func (p *parser) skipDigits() bool {
+	start := p.pos
+	for p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '9' {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *parser) parseString() (string, error) {
+	quote := p.data[p.pos] // '"', or '\'' in JSON5 mode
+	p.pos++
+	start := p.pos
+	// fast path: no escapes and plain ASCII/valid UTF-8
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if c == quote {
+			res := string(p.data[start:p.pos])
+			if err := p.checkStringLength(len(res)); err != nil {
+				return "", err
+			}
+			if utf8.ValidString(res) {
+				p.pos++
+				return res, nil
+			}
+			break
+		}
+		if c == '\\' || c < 0x20 {
+			break
+		}
+		p.pos++
+	}
+	p.pos = start
+	buffer := make([]byte, 0, 16)
+	for {
+		if p.pos >= len(p.data) {
+			return "", p.syntaxError("unexpected end of JSON input in string")
+		}
+		c := p.data[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return string(buffer), nil
+		case c < 0x20 && (!p.config.JSON5 || c == '\n' || c == '\r'):
+			return "", p.syntaxError("invalid control character in string")
+		case c == '\\':
+			escape := p.parseEscape
+			if p.config.JSON5 {
+				escape = p.parseEscape5
+			}
+			res, err := escape(buffer)
+			if err != nil {
+				return "", err
+			}
+			buffer = res
+		case c < utf8.RuneSelf:
+			buffer = append(buffer, c)
+			p.pos++
+		default:
+			r, size := utf8.DecodeRune(p.data[p.pos:])
+			if r == utf8.RuneError && size == 1 && p.config.Strict {
+				return "", p.syntaxError("invalid UTF-8 in string")
+			}
+			buffer = utf8.AppendRune(buffer, r) // invalid bytes become U+FFFD like encoding/json
+			p.pos += size
+		}
+		if err := p.checkStringLength(len(buffer)); err != nil {
+			return "", err
+		}
+	}
+}
+
+func (p *parser) checkStringLength(length int) error {
+	if p.config.MaxStringLength > 0 && length > p.config.MaxStringLength {
+		return p.limitError("string length", p.config.MaxStringLength)
+	}
+	return nil
+}
+
+func (p *parser) parseEscape(buffer []byte) ([]byte, error) {
+	if p.pos+1 >= len(p.data) {
+		return nil, p.syntaxError("unexpected end of JSON input in string escape")
+	}
+	c := p.data[p.pos+1]
+	switch c {
+	case '"', '\\', '/':
+		p.pos += 2
+		return append(buffer, c), nil
+	case 'b':
+		p.pos += 2
+		return append(buffer, '\b'), nil
+	case 'f':
+		p.pos += 2
+		return append(buffer, '\f'), nil
+	case 'n':
+		p.pos += 2
+		return append(buffer, '\n'), nil
+	case 'r':
+		p.pos += 2
+		return append(buffer, '\r'), nil
+	case 't':
+		p.pos += 2
+		return append(buffer, '\t'), nil
+	case 'u':
+		r, ok := p.readHex4(p.pos + 2)
+		if !ok {
+			return nil, p.syntaxError("invalid unicode escape in string")
+		}
+		p.pos += 6
+		if utf16.IsSurrogate(r) {
+			if r2, ok := p.readSurrogateTail(); ok {
+				if pair := utf16.DecodeRune(r, r2); pair != utf8.RuneError {
+					p.pos += 6
+					return utf8.AppendRune(buffer, pair), nil
+				}
+			}
+			if p.config.Strict {
+				return nil, p.syntaxErrorAt(p.pos-6, "lone surrogate in string escape")
+			}
+			return utf8.AppendRune(buffer, utf8.RuneError), nil // lone surrogate like encoding/json
+		}
+		return utf8.AppendRune(buffer, r), nil
+	default:
+		return nil, p.syntaxError("invalid escape character " + strconv.QuoteRune(rune(c)) + " in string")
+	}
+}
+
+// readSurrogateTail reads "\uXXXX" at current position without consuming it
+//
+// readSurrogateTail 读取当前位置的 "\uXXXX" 但不消费它
+func (p *parser) readSurrogateTail() (rune, bool) {
+	if p.pos+1 >= len(p.data) || p.data[p.pos] != '\\' || p.data[p.pos+1] != 'u' {
+		return 0, false
+	}
+	return p.readHex4(p.pos + 2)
+}
+
+func (p *parser) readHex4(pos int) (rune, bool) {
+	if pos+4 > len(p.data) {
+		return 0, false
+	}
+	var r rune
+	for _, c := range p.data[pos : pos+4] {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+		case c >= 'a' && c <= 'f':
+			c = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return 0, false
+		}
+		r = r*16 + rune(c)
+	}
+	return r, true
+}
@@ -0,0 +1,94 @@
+package jsonparse_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx/internal/jsonparse"
+)
+
+func TestParse_MatchesEncodingJSON(t *testing.T) {
+	for _, data := range []string{
+		`null`,
+		`true`,
+		` [1, -2.5e3, 0, "", {}] `,
+		`{"a": {"b": [true, false, null]}, "c": "\"\\\/\b\f\n\r\té😀"}`,
+		`{"dup": 1, "dup": 2}`,
+		`"lone \ud83d surrogate"`,
+		"\"invalid \xff utf8\"",
+		`{"a": 1} trailing`,
+	} {
+		res, err := jsonparse.Parse([]byte(data), nil)
+		require.NoError(t, err, data)
+
+		var expected interface{}
+		decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+		decoder.UseNumber()
+		require.NoError(t, decoder.Decode(&expected))
+		require.Equal(t, expected, res, data)
+	}
+}
+
+func TestParse_SyntaxError(t *testing.T) {
+	for _, data := range []string{``, ` `, `{`, `[1,]`, `{"a":1,}`, `-`, `1.`, `1e`, `"\x"`, "\"\t\"", `nul`} {
+		_, err := jsonparse.Parse([]byte(data), nil)
+		require.Error(t, err, data)
+
+		var syntaxError *jsonparse.SyntaxError
+		require.ErrorAs(t, err, &syntaxError, data)
+	}
+}
+
+func TestParse_UnterminatedComment(t *testing.T) {
+	for _, data := range []string{`{"a":1} /* never closed`, `[1, /* never closed`, `/* never closed */ /* again`} {
+		_, err := jsonparse.Parse([]byte(data), &jsonparse.Config{Comments: true})
+		require.Error(t, err, data)
+		t.Log(err)
+
+		var syntaxError *jsonparse.SyntaxError
+		require.ErrorAs(t, err, &syntaxError, data)
+		require.Equal(t, "unterminated block comment", syntaxError.Reason, data)
+		require.Equal(t, int64(strings.LastIndex(data, "/*")), syntaxError.Offset, data)
+	}
+
+	res, err := jsonparse.Parse([]byte(`[1 /* a */, 2] /**/`), &jsonparse.Config{Comments: true, Strict: true})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+}
+
+func TestParse_Limit(t *testing.T) {
+	_, err := jsonparse.Parse([]byte(`{"a": [1, 2, 3]}`), &jsonparse.Config{MaxArrayLength: 2})
+	require.ErrorIs(t, err, jsonparse.ErrLimitExceeded)
+
+	var limitError *jsonparse.LimitError
+	require.ErrorAs(t, err, &limitError)
+	require.Equal(t, "array length", limitError.Limit)
+	require.Equal(t, int64(2), limitError.Max)
+	t.Log(limitError)
+}
+
+func TestParse_Strict(t *testing.T) {
+	_, err := jsonparse.Parse([]byte("{\"a\": 1,\n \"a\": 2}"), &jsonparse.Config{Strict: true})
+	require.Error(t, err)
+
+	var syntaxError *jsonparse.SyntaxError
+	require.ErrorAs(t, err, &syntaxError)
+	require.Equal(t, int64(10), syntaxError.Offset)
+	require.Equal(t, 2, syntaxError.Line)
+	require.Equal(t, 2, syntaxError.Column)
+	t.Log(syntaxError)
+}
+
+func TestPosition(t *testing.T) {
+	data := []byte("ab\nçd\ne")
+	line, column := jsonparse.Position(data, 6)
+	require.Equal(t, 2, line)
+	require.Equal(t, 3, column)
+
+	line, column = jsonparse.Position(data, 100)
+	require.Equal(t, 3, line)
+	require.Equal(t, 2, column)
+}
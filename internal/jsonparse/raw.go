@@ -0,0 +1,115 @@
+package jsonparse
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Member returns raw bytes of the value stored at key in the JSON object held by data
+// data must be valid JSON, ok is false when data is not an object or the key is absent
+// When the key repeats the last occurrence wins, matching encoding/json
+//
+// Member 返回 data 中 JSON 对象在 key 处存储的值的原始字节
+// data 必须是合法的 JSON，当 data 不是对象或键不存在时 ok 为 false
+// 键重复时以最后一次出现为准，与 encoding/json 一致
+func Member(data []byte, key string) (value []byte, ok bool) {
+	pos := skipBlank(data, 0)
+	if pos >= len(data) || data[pos] != '{' {
+		return nil, false
+	}
+	pos = skipBlank(data, pos+1)
+	for pos < len(data) && data[pos] == '"' {
+		end := skipString(data, pos)
+		match := keyEquals(data[pos:end], key)
+		pos = skipBlank(data, end)
+		pos = skipBlank(data, pos+1) // colon
+		start := pos
+		pos = SkipValue(data, pos)
+		if match {
+			value, ok = data[start:pos], true
+		}
+		pos = skipBlank(data, pos)
+		if pos < len(data) && data[pos] == ',' {
+			pos = skipBlank(data, pos+1)
+		}
+	}
+	return value, ok
+}
+
+// SkipValue returns the offset just past the valid JSON value starting at pos
+//
+// SkipValue 返回从 pos 开始的合法 JSON 值之后的偏移量
+func SkipValue(data []byte, pos int) int {
+	if pos >= len(data) {
+		return pos
+	}
+	switch data[pos] {
+	case '"':
+		return skipString(data, pos)
+	case '{', '[':
+		depth := 0
+		for pos < len(data) {
+			switch data[pos] {
+			case '"':
+				pos = skipString(data, pos)
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return pos + 1
+				}
+			}
+			pos++
+		}
+		return pos
+	default:
+		for pos < len(data) {
+			switch data[pos] {
+			case ',', '}', ']', ' ', '\t', '\n', '\r':
+				return pos
+			}
+			pos++
+		}
+		return pos
+	}
+}
+
+func skipString(data []byte, pos int) int {
+	for pos++; pos < len(data); pos++ {
+		switch data[pos] {
+		case '\\':
+			pos++
+		case '"':
+			return pos + 1
+		}
+	}
+	return pos
+}
+
+func skipBlank(data []byte, pos int) int {
+	for pos < len(data) {
+		switch data[pos] {
+		case ' ', '\t', '\n', '\r':
+			pos++
+		default:
+			return pos
+		}
+	}
+	return pos
+}
+
+// keyEquals compares quoted JSON string with key, decoding only when escapes are present
+//
+// keyEquals 比较带引号的 JSON 字符串与 key，仅在包含转义时才解码
+func keyEquals(quoted []byte, key string) bool {
+	if bytes.IndexByte(quoted, '\\') < 0 {
+		return string(quoted[1:len(quoted)-1]) == key
+	}
+	var decoded string
+	if err := json.Unmarshal(quoted, &decoded); err != nil {
+		return false
+	}
+	return decoded == key
+}
@@ -0,0 +1,30 @@
+package jsonparse_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx/internal/jsonparse"
+)
+
+func TestMember(t *testing.T) {
+	data := []byte(` { "a" : {"x": "}]\"", "y": [1, {"z": 2}]} , "b1": -1.5e3, "c": "v", "c": null } `)
+
+	value, ok := jsonparse.Member(data, "a")
+	require.True(t, ok)
+	require.Equal(t, `{"x": "}]\"", "y": [1, {"z": 2}]}`, string(value))
+
+	value, ok = jsonparse.Member(data, "b1")
+	require.True(t, ok)
+	require.Equal(t, `-1.5e3`, string(value))
+
+	value, ok = jsonparse.Member(data, "c")
+	require.True(t, ok)
+	require.Equal(t, `null`, string(value))
+
+	_, ok = jsonparse.Member(data, "missing")
+	require.False(t, ok)
+
+	_, ok = jsonparse.Member([]byte(`[1, 2]`), "a")
+	require.False(t, ok)
+}
@@ -0,0 +1,155 @@
+package simplejsonx
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// Kind names the JSON type of a node
+//
+// Kind 表示节点的 JSON 类型
+type Kind int
+
+const (
+	KindUnknown Kind = iota // Go value without JSON counterpart, such as struct from Wrap // 没有对应 JSON 类型的 Go 值，例如 Wrap 的结构体
+	KindNull                // null or missing value // null 或缺失的值
+	KindBool                // true or false // true 或 false
+	KindNumber              // json.Number or native Go number // json.Number 或 Go 原生数字
+	KindString              // string // 字符串
+	KindArray               // []interface{} or typed Go slice // []interface{} 或具体类型的 Go 切片
+	KindObject              // map[string]interface{} or typed Go map with string keys // map[string]interface{} 或键为字符串的具体类型 Go 映射
+)
+
+// String returns lowercase name of the kind, such as "object"
+//
+// String 返回类型的小写名称，例如 "object"
+func (k Kind) String() string {
+	switch k {
+	case KindNull:
+		return "null"
+	case KindBool:
+		return "bool"
+	case KindNumber:
+		return "number"
+	case KindString:
+		return "string"
+	case KindArray:
+		return "array"
+	case KindObject:
+		return "object"
+	default:
+		return "unknown"
+	}
+}
+
+// kindOf classifies Go value held by simplejson.Json, []byte counts as string like encoding/json writes it
+//
+// kindOf 对 simplejson.Json 持有的 Go 值进行分类，[]byte 按 encoding/json 的写法视为字符串
+func kindOf(value interface{}) Kind {
+	switch value.(type) {
+	case nil:
+		return KindNull
+	case bool:
+		return KindBool
+	case json.Number, float64, float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return KindNumber
+	case string, []byte:
+		return KindString
+	case []interface{}:
+		return KindArray
+	case map[string]interface{}:
+		return KindObject
+	}
+	switch rv := reflect.ValueOf(value); rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return KindArray
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			return KindObject
+		}
+	}
+	return KindUnknown
+}
+
+// KindOf reports the JSON kind of node, recognising both json.Number and native Go numbers from Wrap
+// Missing values, such as the result of Get on absent key, report KindNull
+//
+// KindOf 返回节点的 JSON 类型，可以识别 json.Number 以及 Wrap 得到的 Go 原生数字
+// 缺失的值（例如对不存在的键调用 Get 的结果）返回 KindNull
+func KindOf(object *simplejson.Json) Kind {
+	if object == nil {
+		return KindNull
+	}
+	return kindOf(object.Interface())
+}
+
+// IsNull reports whether node is null or missing
+//
+// IsNull 判断节点是否为 null 或缺失
+func IsNull(object *simplejson.Json) bool {
+	return KindOf(object) == KindNull
+}
+
+// Len returns element count of array or field count of object
+// Returns errors when node is neither array nor object
+//
+// Len 返回数组的元素个数或对象的字段个数
+// 当节点既不是数组也不是对象时返回错误
+func Len(object *simplejson.Json) (int, error) {
+	if object == nil {
+		return 0, errors.New("parameter object is missing")
+	}
+	switch kind := kindOf(object.Interface()); kind {
+	case KindArray, KindObject:
+		return reflect.ValueOf(object.Interface()).Len(), nil
+	default:
+		return 0, errors.Errorf("unable to get length of JSON %s", kind)
+	}
+}
+
+// Keys returns field names of object in sorted order
+// Returns errors when node is not object
+//
+// Keys 按排序顺序返回对象的字段名
+// 当节点不是对象时返回错误
+func Keys(object *simplejson.Json) ([]string, error) {
+	if object == nil {
+		return nil, errors.New("parameter object is missing")
+	}
+	value := object.Interface()
+	if kind := kindOf(value); kind != KindObject {
+		return nil, errors.Errorf("unable to get keys of JSON %s", kind)
+	}
+	return sortedKeys(toGeneric(value).(map[string]interface{})), nil
+}
+
+func sortedKeys(fields map[string]interface{}) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Has reports whether dot-separated path like "user.profile.name" exists, null values count as present
+//
+// Has 判断类似 "user.profile.name" 的点分隔路径是否存在，值为 null 也视为存在
+func Has(object *simplejson.Json, path string) bool {
+	if object == nil || path == "" {
+		return false
+	}
+	value := object
+	for _, key := range strings.Split(path, ".") {
+		var exist bool
+		if value, exist = value.CheckGet(key); !exist {
+			return false
+		}
+	}
+	return true
+}
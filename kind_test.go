@@ -0,0 +1,93 @@
+package simplejsonx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestKindOf(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"n": null, "b": true, "i": 1, "f": 1.5, "s": "x", "a": [], "o": {}}`))
+	require.NoError(t, err)
+
+	for key, kind := range map[string]simplejsonx.Kind{
+		"n":       simplejsonx.KindNull,
+		"b":       simplejsonx.KindBool,
+		"i":       simplejsonx.KindNumber,
+		"f":       simplejsonx.KindNumber,
+		"s":       simplejsonx.KindString,
+		"a":       simplejsonx.KindArray,
+		"o":       simplejsonx.KindObject,
+		"missing": simplejsonx.KindNull,
+	} {
+		require.Equal(t, kind, simplejsonx.KindOf(object.Get(key)), key)
+	}
+	require.Equal(t, simplejsonx.KindObject, simplejsonx.KindOf(object))
+	require.Equal(t, "object", simplejsonx.KindOf(object).String())
+}
+
+func TestKindOf_Wrap(t *testing.T) {
+	type User struct{ Name string }
+
+	require.Equal(t, simplejsonx.KindNumber, simplejsonx.KindOf(simplejsonx.Wrap(18)))
+	require.Equal(t, simplejsonx.KindNumber, simplejsonx.KindOf(simplejsonx.Wrap(uint8(1))))
+	require.Equal(t, simplejsonx.KindNumber, simplejsonx.KindOf(simplejsonx.Wrap(2.5)))
+	require.Equal(t, simplejsonx.KindArray, simplejsonx.KindOf(simplejsonx.Wrap([]string{"a"})))
+	require.Equal(t, simplejsonx.KindObject, simplejsonx.KindOf(simplejsonx.Wrap(map[string]int{})))
+	require.Equal(t, simplejsonx.KindUnknown, simplejsonx.KindOf(simplejsonx.Wrap(User{})))
+	require.Equal(t, simplejsonx.KindNull, simplejsonx.KindOf(nil))
+}
+
+func TestIsNull(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"n": null, "v": 0}`))
+	require.NoError(t, err)
+
+	require.True(t, simplejsonx.IsNull(object.Get("n")))
+	require.True(t, simplejsonx.IsNull(object.Get("missing")))
+	require.False(t, simplejsonx.IsNull(object.Get("v")))
+}
+
+func TestLen(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"a": [1, 2, 3], "o": {"x": 1}, "s": "abc"}`))
+	require.NoError(t, err)
+
+	size, err := simplejsonx.Len(object.Get("a"))
+	require.NoError(t, err)
+	require.Equal(t, 3, size)
+
+	size, err = simplejsonx.Len(object.Get("o"))
+	require.NoError(t, err)
+	require.Equal(t, 1, size)
+
+	size, err = simplejsonx.Len(simplejsonx.Wrap([]int{1, 2}))
+	require.NoError(t, err)
+	require.Equal(t, 2, size)
+
+	_, err = simplejsonx.Len(object.Get("s"))
+	require.Error(t, err)
+}
+
+func TestKeys(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"b": 1, "a": 2, "c": [3]}`))
+	require.NoError(t, err)
+
+	keys, err := simplejsonx.Keys(object)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, keys)
+
+	_, err = simplejsonx.Keys(object.Get("c"))
+	require.Error(t, err)
+}
+
+func TestHas(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"user": {"profile": {"name": "yyle88", "bio": null}}}`))
+	require.NoError(t, err)
+
+	require.True(t, simplejsonx.Has(object, "user"))
+	require.True(t, simplejsonx.Has(object, "user.profile.name"))
+	require.True(t, simplejsonx.Has(object, "user.profile.bio"))
+	require.False(t, simplejsonx.Has(object, "user.profile.age"))
+	require.False(t, simplejsonx.Has(object, "user.profile.name.first"))
+	require.False(t, simplejsonx.Has(object, ""))
+}
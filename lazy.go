@@ -0,0 +1,167 @@
+package simplejsonx
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+	"github.com/yyle88/simplejsonx/internal/jsonparse"
+	"github.com/yyle88/simplejsonx/internal/utils"
+)
+
+// LazyDocument keeps the original JSON bytes and decodes only the sub-trees being accessed
+// Decoded sub-trees are cached per path, Raw returns the untouched input for forwarding
+// Cached values are shared, modifying them affects later lookups of the same path
+// Safe to use from multiple goroutines
+//
+// LazyDocument 保留原始 JSON 字节，只解码被访问到的子树
+// 解码后的子树按路径缓存，Raw 返回未经改动的输入以便原样转发
+// 缓存的值是共享的，修改它们会影响之后对同一路径的查找
+// 可以在多个协程中并发使用
+type LazyDocument struct {
+	data  []byte
+	mutex sync.Mutex
+	cache map[string]*simplejson.Json
+}
+
+// NewLazy creates LazyDocument from raw JSON bytes
+// Input is validated up front without building the tree, data must not be modified afterwards
+// Trailing data after the value is rejected, such as a second document
+//
+// NewLazy 从原始 JSON 字节创建 LazyDocument
+// 预先校验输入但不构建树结构，之后不能再修改 data
+// 值之后的尾随数据会被拒绝，例如第二个文档
+func NewLazy(data []byte) (*LazyDocument, error) {
+	if !json.Valid(data) {
+		if _, err := LoadWith(data, NewLoadConfig().WithStrict()); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("unable to parse JSON: invalid JSON")
+	}
+	return &LazyDocument{data: data, cache: make(map[string]*simplejson.Json)}, nil
+}
+
+// Raw returns the original JSON bytes
+//
+// Raw 返回原始 JSON 字节
+func (d *LazyDocument) Raw() []byte {
+	return d.data
+}
+
+// RawAt returns raw bytes of the value at dot-separated path without decoding it
+// Empty path returns the whole document, exist is false when any key on the path is absent
+//
+// RawAt 返回点分隔路径处值的原始字节，不进行解码
+// 空路径返回整个文档，路径上任一键不存在时 exist 为 false
+func (d *LazyDocument) RawAt(path string) (raw []byte, exist bool) {
+	return d.rawAt(splitLazyPath(path))
+}
+
+func (d *LazyDocument) rawAt(keys []string) (raw []byte, exist bool) {
+	raw = d.data
+	for _, key := range keys {
+		if raw, exist = jsonparse.Member(raw, key); !exist {
+			return nil, false
+		}
+	}
+	return raw, true
+}
+
+// Get decodes the value at dot-separated path into simplejson.Json, reusing cached results
+// Empty path decodes the whole document, exist is false when any key on the path is absent
+//
+// Get 将点分隔路径处的值解码成 simplejson.Json，并复用缓存结果
+// 空路径解码整个文档，路径上任一键不存在时 exist 为 false
+func (d *LazyDocument) Get(path string) (object *simplejson.Json, exist bool, err error) {
+	return d.get(splitLazyPath(path))
+}
+
+// get decodes the value found by following keys one level each, caching results per key sequence
+//
+// get 逐层按 keys 查找并解码值，按键序列缓存结果
+func (d *LazyDocument) get(keys []string) (object *simplejson.Json, exist bool, err error) {
+	cacheKey := lazyCacheKey(keys)
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if object, exist = d.cache[cacheKey]; exist {
+		return object, true, nil
+	}
+	raw, exist := d.rawAt(keys)
+	if !exist {
+		return nil, false, nil
+	}
+	object, err = Load(raw)
+	if err != nil {
+		return nil, false, err
+	}
+	d.cache[cacheKey] = object
+	return object, true, nil
+}
+
+func splitLazyPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, ".")
+}
+
+// lazyCacheKey joins quoted keys, so key "a.b" and path "a.b" never share cache entry
+//
+// lazyCacheKey 将加引号的键拼接起来，使键 "a.b" 与路径 "a.b" 不会共用缓存条目
+func lazyCacheKey(keys []string) string {
+	quoted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		quoted = append(quoted, strconv.Quote(key))
+	}
+	return strings.Join(quoted, ".")
+}
+
+// LazyExtract decodes and parses the value at the specified key of LazyDocument
+// key is one key of the root object like Extract, dots are part of the key, use LazyExplore for paths
+// Returns errors when the key is missing, when type conversion fails
+//
+// LazyExtract 解码 LazyDocument 中指定键的值并解析成目标类型
+// 与 Extract 一样 key 是根对象的单个键，其中的点号属于键本身，路径请使用 LazyExplore
+// 当键缺失或类型转换失败时返回错误
+func LazyExtract[T any](document *LazyDocument, key string) (T, error) {
+	if document == nil {
+		return utils.Zero[T](), errors.New("parameter document is missing")
+	}
+	if key == "" {
+		return utils.Zero[T](), errors.New("parameter key is missing")
+	}
+	object, exist, err := document.get([]string{key})
+	if err != nil {
+		return utils.Zero[T](), err
+	}
+	if !exist {
+		return utils.Zero[T](), errors.Errorf("unable to find key %q in JSON object", key)
+	}
+	return Resolve[T](object)
+}
+
+// LazyExplore decodes only the sub-tree at dot-separated path of LazyDocument and parses it
+// Returns parsed value, existence boolean, and possible conversion errors
+//
+// LazyExplore 只解码 LazyDocument 中点分隔路径处的子树并解析成目标类型
+// 返回解析后的值、存在性布尔值和可能的转换错误
+func LazyExplore[T any](document *LazyDocument, path string) (T, bool, error) {
+	if document == nil {
+		return utils.Zero[T](), false, errors.New("parameter document is missing")
+	}
+	if path == "" {
+		return utils.Zero[T](), false, errors.New("parameter path is missing")
+	}
+	object, exist, err := document.Get(path)
+	if err != nil || !exist {
+		return utils.Zero[T](), false, err
+	}
+	res, err := Resolve[T](object)
+	if err != nil {
+		return utils.Zero[T](), false, errors.WithMessagef(err, "unable to resolve JSON value at %s", path)
+	}
+	return res, true, nil
+}
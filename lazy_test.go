@@ -0,0 +1,97 @@
+package simplejsonx_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestNewLazy(t *testing.T) {
+	data := []byte(`{"user": {"name": "yyle88", "age": 18}, "payload": [1, {"x": true}]}`)
+
+	document, err := simplejsonx.NewLazy(data)
+	require.NoError(t, err)
+	require.Equal(t, data, document.Raw())
+
+	raw, exist := document.RawAt("payload")
+	require.True(t, exist)
+	require.Equal(t, `[1, {"x": true}]`, string(raw))
+
+	_, exist = document.RawAt("user.missing")
+	require.False(t, exist)
+
+	_, err = simplejsonx.NewLazy([]byte(`{"user": `))
+	require.Error(t, err)
+
+	document, err = simplejsonx.NewLazy([]byte(`{"a": 1} {"b": 2}`))
+	require.Error(t, err)
+	require.Nil(t, document)
+}
+
+func TestLazyDocument_Get(t *testing.T) {
+	document, err := simplejsonx.NewLazy([]byte(`{"user": {"name": "yyle88"}}`))
+	require.NoError(t, err)
+
+	object, exist, err := document.Get("user")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, "yyle88", object.Get("name").MustString())
+
+	again, exist, err := document.Get("user")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Same(t, object, again)
+
+	var wg sync.WaitGroup
+	for idx := 0; idx < 8; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			name, exist, err := simplejsonx.LazyExplore[string](document, "user.name")
+			require.NoError(t, err)
+			require.True(t, exist)
+			require.Equal(t, "yyle88", name)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLazyExtract(t *testing.T) {
+	document, err := simplejsonx.NewLazy([]byte(`{"id": 7, "user": {"age": 18}, "user.age": 20}`))
+	require.NoError(t, err)
+
+	id, err := simplejsonx.LazyExtract[int](document, "id")
+	require.NoError(t, err)
+	require.Equal(t, 7, id)
+
+	age, err := simplejsonx.LazyExtract[int](document, "user.age")
+	require.NoError(t, err)
+	require.Equal(t, 20, age)
+
+	age, exist, err := simplejsonx.LazyExplore[int](document, "user.age")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, 18, age)
+
+	_, err = simplejsonx.LazyExtract[int](document, "missing")
+	require.Error(t, err)
+
+	_, err = simplejsonx.LazyExtract[string](document, "id")
+	require.Error(t, err)
+}
+
+func TestLazyExplore(t *testing.T) {
+	document, err := simplejsonx.NewLazy([]byte(`{"user": {"profile": {"age": 18}}}`))
+	require.NoError(t, err)
+
+	age, exist, err := simplejsonx.LazyExplore[int64](document, "user.profile.age")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, int64(18), age)
+
+	_, exist, err = simplejsonx.LazyExplore[int64](document, "user.profile.name")
+	require.NoError(t, err)
+	require.False(t, exist)
+}
@@ -0,0 +1,290 @@
+package simplejsonx
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// LineMode decides how LineReader handles blank and malformed lines
+//
+// LineMode 决定 LineReader 如何处理空行和格式错误的行
+type LineMode int
+
+const (
+	LineSkip    LineMode = iota // ignore the line and continue // 忽略该行并继续
+	LineCollect                 // record LineError and continue // 记录 LineError 并继续
+	LineStop                    // stop iteration with LineError // 以 LineError 停止迭代
+)
+
+// LineError reports problem with one line of NDJSON stream
+//
+// LineError 表示 NDJSON 流中某一行的问题
+type LineError struct {
+	Line int   // 1-based line number // 从 1 开始的行号
+	Err  error // underlying problem // 底层问题
+}
+
+func (e *LineError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// errBlankLine is reported for blank lines when BlankLines is not LineSkip
+//
+// errBlankLine 在 BlankLines 不是 LineSkip 时用于报告空行
+var errBlankLine = errors.New("blank line")
+
+// LineReaderConfig controls LineReader behavior
+// Defaults skip blank lines and stop at the first malformed line
+//
+// LineReaderConfig 控制 LineReader 的行为
+// 默认跳过空行，并在遇到第一个格式错误的行时停止
+type LineReaderConfig struct {
+	BlankLines     LineMode    // handling of lines holding only whitespace // 仅包含空白字符的行的处理方式
+	MalformedLines LineMode    // handling of lines failing to parse // 解析失败的行的处理方式
+	LoadConfig     *LoadConfig // limits and syntax applied to each line, data after the first value is always rejected // 应用于每一行的限制和语法，始终拒绝第一个值之后的数据
+}
+
+// NewLineReaderConfig creates LineReaderConfig with default settings
+//
+// NewLineReaderConfig 创建默认设置的 LineReaderConfig
+func NewLineReaderConfig() *LineReaderConfig {
+	return &LineReaderConfig{
+		BlankLines:     LineSkip,
+		MalformedLines: LineStop,
+	}
+}
+
+// WithBlankLines sets handling of blank lines
+//
+// WithBlankLines 设置空行的处理方式
+func (c *LineReaderConfig) WithBlankLines(mode LineMode) *LineReaderConfig {
+	c.BlankLines = mode
+	return c
+}
+
+// WithMalformedLines sets handling of malformed lines
+//
+// WithMalformedLines 设置格式错误的行的处理方式
+func (c *LineReaderConfig) WithMalformedLines(mode LineMode) *LineReaderConfig {
+	c.MalformedLines = mode
+	return c
+}
+
+// WithLoadConfig sets limits and syntax applied when parsing each line
+//
+// WithLoadConfig 设置解析每一行时应用的限制和语法
+func (c *LineReaderConfig) WithLoadConfig(config *LoadConfig) *LineReaderConfig {
+	c.LoadConfig = config
+	return c
+}
+
+// LineReader iterates newline-delimited JSON (NDJSON / JSON Lines) records from io.Reader
+// Reads one line at a time, so memory stays bounded by the longest line
+//
+// LineReader 从 io.Reader 迭代读取换行分隔的 JSON（NDJSON / JSON Lines）记录
+// 每次只读取一行，内存占用以最长的一行为上限
+type LineReader struct {
+	reader *bufio.Reader
+	config *LineReaderConfig
+	line   int
+	object *simplejson.Json
+	err    error
+	errs   []*LineError
+}
+
+// NewLineReader creates LineReader with default settings
+//
+// NewLineReader 创建默认设置的 LineReader
+func NewLineReader(r io.Reader) *LineReader {
+	return NewLineReaderWith(r, nil)
+}
+
+// NewLineReaderWith creates LineReader using given config, nil config falls back to default settings
+//
+// NewLineReaderWith 使用给定配置创建 LineReader，配置为 nil 时使用默认设置
+func NewLineReaderWith(r io.Reader, config *LineReaderConfig) *LineReader {
+	if config == nil {
+		config = NewLineReaderConfig()
+	}
+	return &LineReader{reader: bufio.NewReader(r), config: config}
+}
+
+// Next advances to the next record, returns false at end of input or when stopped by an error
+//
+// Next 前进到下一条记录，在输入结束或因错误停止时返回 false
+func (r *LineReader) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	for {
+		data, err := r.readLine()
+		if err != nil {
+			if err != io.EOF {
+				r.err = err
+			}
+			r.object = nil
+			return false
+		}
+		if len(data) == 0 {
+			if r.handle(r.line, r.config.BlankLines, errBlankLine) {
+				continue
+			}
+			return false
+		}
+		object, err := r.parse(data)
+		if err != nil {
+			if r.handle(r.line, r.config.MalformedLines, err) {
+				continue
+			}
+			return false
+		}
+		r.object = object
+		return true
+	}
+}
+
+// parse loads one line using LoadConfig of reader, data after the first value makes the line malformed
+//
+// parse 使用 reader 的 LoadConfig 加载一行，第一个值之后还有数据时该行视为格式错误
+func (r *LineReader) parse(data []byte) (*simplejson.Json, error) {
+	config := r.config.LoadConfig
+	if config == nil {
+		config = NewLoadConfig()
+	}
+	parseConfig := config.parseConfig()
+	parseConfig.NoTrailingData = true
+	return loadWith(data, config, parseConfig)
+}
+
+// readLine reads the next line with surrounding whitespace trimmed, returns io.EOF at end of input
+//
+// readLine 读取下一行并去除首尾空白，输入结束时返回 io.EOF
+func (r *LineReader) readLine() ([]byte, error) {
+	data, err := r.reader.ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return nil, errors.WithMessage(err, "unable to read line")
+	}
+	if len(data) == 0 && err == io.EOF {
+		return nil, io.EOF
+	}
+	r.line++
+	return bytes.TrimSpace(data), nil
+}
+
+// handle applies mode to problem on given line, returns true when iteration continues
+//
+// handle 对指定行的问题应用处理方式，迭代继续时返回 true
+func (r *LineReader) handle(line int, mode LineMode, err error) bool {
+	switch mode {
+	case LineCollect:
+		r.errs = append(r.errs, &LineError{Line: line, Err: err})
+		return true
+	case LineStop:
+		r.err = &LineError{Line: line, Err: err}
+		r.object = nil
+		return false
+	default:
+		return true
+	}
+}
+
+// Object returns the record read by the latest successful Next
+//
+// Object 返回最近一次成功调用 Next 读取的记录
+func (r *LineReader) Object() *simplejson.Json {
+	return r.object
+}
+
+// Line returns 1-based line number of the latest line read
+//
+// Line 返回最近读取的行的行号，从 1 开始
+func (r *LineReader) Line() int {
+	return r.line
+}
+
+// Err returns the error that stopped iteration, nil at normal end of input
+//
+// Err 返回导致迭代停止的错误，正常结束时返回 nil
+func (r *LineReader) Err() error {
+	return r.err
+}
+
+// Errors returns problems recorded by LineCollect mode
+//
+// Errors 返回 LineCollect 模式下记录的问题
+func (r *LineReader) Errors() []*LineError {
+	return r.errs
+}
+
+// LineWriter writes JSON documents as newline-delimited JSON records
+// Output is buffered, call Flush when done
+//
+// LineWriter 将 JSON 文档写成换行分隔的 JSON 记录
+// 输出带有缓冲，结束时需要调用 Flush
+type LineWriter struct {
+	writer  *bufio.Writer
+	config  *DumpConfig
+	scratch bytes.Buffer
+}
+
+// NewLineWriter creates LineWriter with default dump settings
+//
+// NewLineWriter 创建使用默认序列化设置的 LineWriter
+func NewLineWriter(w io.Writer) *LineWriter {
+	return NewLineWriterWith(w, nil)
+}
+
+// NewLineWriterWith creates LineWriter using given dump config
+// Indent and trailing newline settings are ignored since each record must stay on one line
+//
+// NewLineWriterWith 使用给定的序列化配置创建 LineWriter
+// 由于每条记录必须位于同一行，缩进和末尾换行设置会被忽略
+func NewLineWriterWith(w io.Writer, config *DumpConfig) *LineWriter {
+	if config == nil {
+		config = NewDumpConfig()
+	}
+	compact := *config
+	compact.Indent = 0
+	compact.TrailingNewline = false
+	return &LineWriter{writer: bufio.NewWriter(w), config: &compact}
+}
+
+// Write appends one JSON document followed by newline
+// Documents failing to serialize leave the output untouched
+//
+// Write 追加一个 JSON 文档并以换行结尾
+// 序列化失败的文档不会影响已有输出
+func (w *LineWriter) Write(object *simplejson.Json) error {
+	if object == nil {
+		return errors.New("parameter object is missing")
+	}
+	w.scratch.Reset()
+	d := &dumper{writer: &w.scratch, config: w.config}
+	if err := d.writeValue(object.Interface(), 0); err != nil {
+		return errors.WithMessage(err, "unable to dump JSON")
+	}
+	w.scratch.WriteByte('\n')
+	if _, err := w.writer.Write(w.scratch.Bytes()); err != nil {
+		return errors.WithMessage(err, "unable to write JSON")
+	}
+	return nil
+}
+
+// Flush writes buffered records into the underlying writer
+//
+// Flush 将缓冲的记录写入底层 writer
+func (w *LineWriter) Flush() error {
+	if err := w.writer.Flush(); err != nil {
+		return errors.WithMessage(err, "unable to write JSON")
+	}
+	return nil
+}
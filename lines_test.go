@@ -0,0 +1,122 @@
+package simplejsonx_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestLineReader(t *testing.T) {
+	data := "{\"id\": 1, \"name\": \"a\"}\n\n{\"id\": 2, \"name\": \"b\"}\r\n{\"id\": 3, \"name\": \"c\"}"
+
+	reader := simplejsonx.NewLineReader(strings.NewReader(data))
+	var ids []int
+	var lines []int
+	for reader.Next() {
+		id, err := simplejsonx.Extract[int](reader.Object(), "id")
+		require.NoError(t, err)
+		ids = append(ids, id)
+		lines = append(lines, reader.Line())
+	}
+	require.NoError(t, reader.Err())
+	require.Equal(t, []int{1, 2, 3}, ids)
+	require.Equal(t, []int{1, 3, 4}, lines)
+}
+
+func TestLineReader_MalformedStop(t *testing.T) {
+	reader := simplejsonx.NewLineReader(strings.NewReader("{\"id\": 1}\n{\"id\": \n{\"id\": 3}\n"))
+
+	require.True(t, reader.Next())
+	require.False(t, reader.Next())
+	require.False(t, reader.Next())
+	require.Error(t, reader.Err())
+	t.Log(reader.Err())
+
+	var lineError *simplejsonx.LineError
+	require.True(t, errors.As(reader.Err(), &lineError))
+	require.Equal(t, 2, lineError.Line)
+}
+
+func TestLineReader_TrailingData(t *testing.T) {
+	data := "{\"a\": 1} garbage\n{\"b\": 2}{\"c\": 3}\n{\"d\": 4}  \n"
+	config := simplejsonx.NewLineReaderConfig().WithMalformedLines(simplejsonx.LineCollect)
+
+	reader := simplejsonx.NewLineReaderWith(strings.NewReader(data), config)
+	require.True(t, reader.Next())
+	require.Equal(t, 4, reader.Object().Get("d").MustInt())
+	require.False(t, reader.Next())
+	require.NoError(t, reader.Err())
+	require.Len(t, reader.Errors(), 2)
+	require.Equal(t, 1, reader.Errors()[0].Line)
+	require.Equal(t, 2, reader.Errors()[1].Line)
+
+	reader = simplejsonx.NewLineReaderWith(strings.NewReader(data), config.WithLoadConfig(simplejsonx.NewLoadConfig().WithRelaxed()))
+	require.True(t, reader.Next())
+	require.Equal(t, 3, reader.Line())
+	require.Len(t, reader.Errors(), 2)
+
+	var lines []int
+	reader = simplejsonx.NewLineReaderWith(strings.NewReader(data), simplejsonx.NewLineReaderConfig().WithMalformedLines(simplejsonx.LineCollect))
+	err := simplejsonx.ProcessLines(context.Background(), reader, 2,
+		func(object *simplejson.Json) (int, error) {
+			return 0, nil
+		},
+		func(line int, res int, err error) error {
+			lines = append(lines, line)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, lines)
+	require.Len(t, reader.Errors(), 2)
+}
+
+func TestLineReader_Collect(t *testing.T) {
+	config := simplejsonx.NewLineReaderConfig().
+		WithBlankLines(simplejsonx.LineCollect).
+		WithMalformedLines(simplejsonx.LineCollect)
+	reader := simplejsonx.NewLineReaderWith(strings.NewReader("{\"id\": 1}\n\nabc\n{\"id\": 4}\n"), config)
+
+	var count int
+	for reader.Next() {
+		count++
+	}
+	require.NoError(t, reader.Err())
+	require.Equal(t, 2, count)
+	require.Len(t, reader.Errors(), 2)
+	require.Equal(t, 2, reader.Errors()[0].Line)
+	require.Equal(t, 3, reader.Errors()[1].Line)
+}
+
+func TestLineReader_BlankStop(t *testing.T) {
+	config := simplejsonx.NewLineReaderConfig().WithBlankLines(simplejsonx.LineStop)
+	reader := simplejsonx.NewLineReaderWith(strings.NewReader("{\"id\": 1}\n \n{\"id\": 3}\n"), config)
+
+	require.True(t, reader.Next())
+	require.False(t, reader.Next())
+	require.Error(t, reader.Err())
+}
+
+func TestLineWriter(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := simplejsonx.NewLineWriterWith(&buffer, simplejsonx.NewDumpConfig().WithIndent(2))
+
+	require.NoError(t, writer.Write(simplejsonx.Wrap(map[string]interface{}{"id": 1, "tags": []string{"a"}})))
+	require.NoError(t, writer.Write(simplejsonx.Wrap("text")))
+	require.Error(t, writer.Write(nil))
+	require.NoError(t, writer.Flush())
+	require.Equal(t, "{\"id\":1,\"tags\":[\"a\"]}\n\"text\"\n", buffer.String())
+
+	reader := simplejsonx.NewLineReader(&buffer)
+	var count int
+	for reader.Next() {
+		count++
+	}
+	require.NoError(t, reader.Err())
+	require.Equal(t, 2, count)
+}
@@ -0,0 +1,209 @@
+package simplejsonx
+
+import (
+	"io"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+	"github.com/yyle88/simplejsonx/internal/jsonparse"
+)
+
+// ErrLimitExceeded is matched via errors.Is when input exceeds a LoadConfig limit
+//
+// ErrLimitExceeded 在输入超过 LoadConfig 限制时可通过 errors.Is 匹配
+var ErrLimitExceeded = jsonparse.ErrLimitExceeded
+
+// LimitError reports which limit was exceeded and where, use errors.As to inspect it
+//
+// LimitError 表示超过了哪项限制以及发生位置，可使用 errors.As 获取
+type LimitError = jsonparse.LimitError
+
+// SyntaxError reports malformed input with byte offset, line and column, use errors.As to inspect it
+//
+// SyntaxError 表示带有字节偏移、行号和列号的输入格式错误，可使用 errors.As 获取
+type SyntaxError = jsonparse.SyntaxError
+
+// LoadConfig controls limits and strictness applied when loading JSON, zero values mean unlimited
+// except depth, which always stays capped so deeply nested input cannot overflow the stack
+// Protects public endpoints against payloads that exhaust memory or stack
+//
+// LoadConfig 控制加载 JSON 时应用的限制和严格程度，零值表示不限制
+// 但深度始终有上限，使深层嵌套的输入不会导致栈溢出
+// 保护公开接口免受耗尽内存或栈空间的恶意负载攻击
+type LoadConfig struct {
+	MaxBytes        int64 // maximum total input size in bytes // 输入的最大总字节数
+	MaxDepth        int   // maximum nesting depth of arrays and objects, zero caps at 10000 like encoding/json // 数组和对象的最大嵌套深度，零值时与 encoding/json 一样限制为 10000
+	MaxArrayLength  int   // maximum number of elements in one array // 单个数组的最大元素数量
+	MaxObjectKeys   int   // maximum number of keys in one object // 单个对象的最大键数量
+	MaxStringLength int   // maximum decoded byte length of one string or key // 单个字符串或键解码后的最大字节长度
+
+	Strict bool // reject duplicate keys, invalid UTF-8, lone surrogates and trailing data // 拒绝重复键、非法 UTF-8、孤立代理项和尾随数据
+
+	AllowComments       bool // accept // line and /* block */ comments // 接受 // 行注释和 /* 块注释 */
+	AllowTrailingCommas bool // accept comma before closing bracket // 接受右括号前的逗号
+	AllowJSON5          bool // accept JSON5 syntax, implies comments and trailing commas // 接受 JSON5 语法，同时接受注释和尾随逗号
+}
+
+// NewLoadConfig creates LoadConfig without limits
+//
+// NewLoadConfig 创建不带限制的 LoadConfig
+func NewLoadConfig() *LoadConfig {
+	return &LoadConfig{}
+}
+
+// WithMaxBytes limits total input size in bytes
+//
+// WithMaxBytes 限制输入的总字节数
+func (c *LoadConfig) WithMaxBytes(maxBytes int64) *LoadConfig {
+	c.MaxBytes = maxBytes
+	return c
+}
+
+// WithMaxDepth limits nesting depth of arrays and objects
+//
+// WithMaxDepth 限制数组和对象的嵌套深度
+func (c *LoadConfig) WithMaxDepth(maxDepth int) *LoadConfig {
+	c.MaxDepth = maxDepth
+	return c
+}
+
+// WithMaxArrayLength limits number of elements in each array
+//
+// WithMaxArrayLength 限制每个数组的元素数量
+func (c *LoadConfig) WithMaxArrayLength(maxArrayLength int) *LoadConfig {
+	c.MaxArrayLength = maxArrayLength
+	return c
+}
+
+// WithMaxObjectKeys limits number of keys in each object
+//
+// WithMaxObjectKeys 限制每个对象的键数量
+func (c *LoadConfig) WithMaxObjectKeys(maxObjectKeys int) *LoadConfig {
+	c.MaxObjectKeys = maxObjectKeys
+	return c
+}
+
+// WithMaxStringLength limits decoded byte length of each string and key
+//
+// WithMaxStringLength 限制每个字符串和键解码后的字节长度
+func (c *LoadConfig) WithMaxStringLength(maxStringLength int) *LoadConfig {
+	c.MaxStringLength = maxStringLength
+	return c
+}
+
+// WithStrict enables strict parsing that rejects input encoding/json silently accepts
+// Duplicate object keys, invalid UTF-8, lone surrogate escapes and data after the top-level value
+// all fail with SyntaxError reporting offset, line and column of the first violation
+//
+// WithStrict 启用严格解析，拒绝 encoding/json 会静默接受的输入
+// 重复的对象键、非法 UTF-8、孤立的代理项转义以及顶层值之后的数据
+// 都会返回 SyntaxError，报告第一个违规处的偏移量、行号和列号
+func (c *LoadConfig) WithStrict() *LoadConfig {
+	c.Strict = true
+	return c
+}
+
+// WithRelaxed accepts JSONC extensions: // and /* */ comments and trailing commas
+// Yields the same tree as loading the input with those extensions stripped
+//
+// WithRelaxed 接受 JSONC 扩展：// 和 /* */ 注释以及尾随逗号
+// 生成的结果树与去除这些扩展后加载输入的结果相同
+func (c *LoadConfig) WithRelaxed() *LoadConfig {
+	c.AllowComments = true
+	c.AllowTrailingCommas = true
+	return c
+}
+
+// WithJSON5 accepts JSON5 syntax on top of JSONC extensions
+// Unquoted keys, single-quoted and multi-line strings, hexadecimal numbers,
+// leading plus signs, bare decimal points, Infinity and NaN are all accepted
+//
+// WithJSON5 在 JSONC 扩展之上接受 JSON5 语法
+// 接受不带引号的键、单引号字符串和多行字符串、十六进制数字、
+// 前导加号、省略整数或小数部分的数字以及 Infinity 和 NaN
+func (c *LoadConfig) WithJSON5() *LoadConfig {
+	c.AllowJSON5 = true
+	return c
+}
+
+// LoadWith creates simplejson.Json instance from raw JSON bytes using given config
+// Returns LimitError matching ErrLimitExceeded when input exceeds configured limits
+// Nil config behaves the same as Load
+//
+// LoadWith 使用给定配置从原始 JSON 字节创建 simplejson.Json 实例
+// 当输入超过配置的限制时返回匹配 ErrLimitExceeded 的 LimitError
+// 配置为 nil 时与 Load 行为相同
+func LoadWith(data []byte, config *LoadConfig) (object *simplejson.Json, err error) {
+	if config == nil {
+		return Load(data)
+	}
+	return loadWith(data, config, config.parseConfig())
+}
+
+// loadWith applies the byte limit of config and parses data using parseConfig derived from it
+//
+// loadWith 应用 config 的字节数限制，并使用由其派生的 parseConfig 解析数据
+func loadWith(data []byte, config *LoadConfig, parseConfig *jsonparse.Config) (*simplejson.Json, error) {
+	if config.MaxBytes > 0 && int64(len(data)) > config.MaxBytes {
+		return simplejson.New(), errors.WithMessage(&LimitError{Limit: "bytes", Max: config.MaxBytes, Offset: config.MaxBytes}, "unable to parse JSON")
+	}
+	value, err := jsonparse.Parse(data, parseConfig)
+	if err != nil {
+		return simplejson.New(), errors.WithMessage(err, "unable to parse JSON")
+	}
+	return Wrap(value), nil
+}
+
+// LoadJSONC creates simplejson.Json instance from JSON with comments, as used by VS Code settings
+// Accepts // and /* */ comments and trailing commas in arrays and objects
+//
+// LoadJSONC 从带注释的 JSON（如 VS Code 配置文件）创建 simplejson.Json 实例
+// 接受 // 和 /* */ 注释，以及数组和对象中的尾随逗号
+func LoadJSONC(data []byte) (object *simplejson.Json, err error) {
+	return LoadWith(data, NewLoadConfig().WithRelaxed())
+}
+
+// LoadJSON5 creates simplejson.Json instance from JSON5 input
+// Numbers become json.Number like Load, hexadecimal ones are converted into decimal
+// Infinity and NaN have no JSON form and become float64 values
+//
+// LoadJSON5 从 JSON5 输入创建 simplejson.Json 实例
+// 数字与 Load 一样解析成 json.Number，十六进制数字会转换成十进制
+// Infinity 和 NaN 没有 JSON 表示形式，会解析成 float64 值
+func LoadJSON5(data []byte) (object *simplejson.Json, err error) {
+	return LoadWith(data, NewLoadConfig().WithJSON5())
+}
+
+// LoadReader creates simplejson.Json instance by reading JSON from reader using given config
+// Stops reading once MaxBytes is exceeded, so oversized bodies are never fully buffered
+// Nil config reads the whole input without limits
+//
+// LoadReader 使用给定配置从 reader 读取 JSON 并创建 simplejson.Json 实例
+// 一旦超过 MaxBytes 就停止读取，超大请求体不会被完整缓冲
+// 配置为 nil 时不带限制地读取全部输入
+func LoadReader(r io.Reader, config *LoadConfig) (object *simplejson.Json, err error) {
+	if r == nil {
+		return simplejson.New(), errors.New("parameter reader is missing")
+	}
+	if config != nil && config.MaxBytes > 0 {
+		r = io.LimitReader(r, config.MaxBytes+1) // one extra byte detects oversized input
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return simplejson.New(), errors.WithMessage(err, "unable to read JSON")
+	}
+	return LoadWith(data, config)
+}
+
+func (c *LoadConfig) parseConfig() *jsonparse.Config {
+	return &jsonparse.Config{
+		MaxDepth:        c.MaxDepth,
+		MaxArrayLength:  c.MaxArrayLength,
+		MaxObjectKeys:   c.MaxObjectKeys,
+		MaxStringLength: c.MaxStringLength,
+		Strict:          c.Strict,
+		Comments:        c.AllowComments || c.AllowJSON5,
+		TrailingCommas:  c.AllowTrailingCommas || c.AllowJSON5,
+		JSON5:           c.AllowJSON5,
+	}
+}
@@ -0,0 +1,249 @@
+package simplejsonx_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestLoadWith(t *testing.T) {
+	data := []byte(`{"name": "yyle88", "tags": ["a", "b"], "info": {"age": 18}}`)
+
+	object, err := simplejsonx.LoadWith(data, simplejsonx.NewLoadConfig().WithMaxDepth(2).WithMaxArrayLength(2))
+	require.NoError(t, err)
+
+	expected, err := simplejsonx.Load(data)
+	require.NoError(t, err)
+	require.Equal(t, expected.Interface(), object.Interface())
+}
+
+func TestLoadWith_Limits(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   string
+		config *simplejsonx.LoadConfig
+		limit  string
+	}{
+		{"bytes", `{"a": 1}`, simplejsonx.NewLoadConfig().WithMaxBytes(4), "bytes"},
+		{"depth", `[[[1]]]`, simplejsonx.NewLoadConfig().WithMaxDepth(2), "depth"},
+		{"array", `[1, 2, 3]`, simplejsonx.NewLoadConfig().WithMaxArrayLength(2), "array length"},
+		{"keys", `{"a": 1, "b": 2}`, simplejsonx.NewLoadConfig().WithMaxObjectKeys(1), "object keys"},
+		{"string", `{"a": "abcdef"}`, simplejsonx.NewLoadConfig().WithMaxStringLength(5), "string length"},
+		{"key", `{"abcdef": 1}`, simplejsonx.NewLoadConfig().WithMaxStringLength(5), "string length"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			object, err := simplejsonx.LoadWith([]byte(tc.data), tc.config)
+			require.Error(t, err)
+			require.NotNil(t, object)
+			t.Log(err)
+			require.True(t, errors.Is(err, simplejsonx.ErrLimitExceeded))
+
+			var limitError *simplejsonx.LimitError
+			require.True(t, errors.As(err, &limitError))
+			require.Equal(t, tc.limit, limitError.Limit)
+		})
+	}
+}
+
+func TestLoadWith_DefaultDepth(t *testing.T) {
+	data := []byte(strings.Repeat("[", 20<<20))
+	for name, load := range map[string]func([]byte) error{
+		"jsonc": func(data []byte) error { _, err := simplejsonx.LoadJSONC(data); return err },
+		"json5": func(data []byte) error { _, err := simplejsonx.LoadJSON5(data); return err },
+		"with":  func(data []byte) error { _, err := simplejsonx.LoadWith(data, simplejsonx.NewLoadConfig()); return err },
+		"reader": func(data []byte) error {
+			_, err := simplejsonx.LoadReader(bytes.NewReader(data), simplejsonx.NewLoadConfig())
+			return err
+		},
+	} {
+		err := load(data)
+		require.ErrorIs(t, err, simplejsonx.ErrLimitExceeded, name)
+
+		var limitError *simplejsonx.LimitError
+		require.True(t, errors.As(err, &limitError), name)
+		require.Equal(t, "depth", limitError.Limit, name)
+		require.Equal(t, int64(10000), limitError.Max, name)
+	}
+
+	nested := strings.Repeat("[", 10000) + strings.Repeat("]", 10000)
+	_, err := simplejsonx.LoadJSONC([]byte(nested))
+	require.NoError(t, err)
+}
+
+func TestLoadWith_InvalidJSON(t *testing.T) {
+	for _, data := range []string{``, `{"a": }`, `[1, 2`, `{"a" 1}`, `[01]`, `"abc`, `tru`} {
+		_, err := simplejsonx.LoadWith([]byte(data), simplejsonx.NewLoadConfig())
+		require.Error(t, err, data)
+		require.False(t, errors.Is(err, simplejsonx.ErrLimitExceeded))
+	}
+}
+
+func TestLoadReader(t *testing.T) {
+	object, err := simplejsonx.LoadReader(strings.NewReader(`{"name": "yyle88"}`), nil)
+	require.NoError(t, err)
+
+	name, err := simplejsonx.Extract[string](object, "name")
+	require.NoError(t, err)
+	require.Equal(t, "yyle88", name)
+}
+
+func TestLoadReader_MaxBytes(t *testing.T) {
+	data := bytes.Repeat([]byte(" "), 1<<20)
+	copy(data, `{"name": "yyle88"}`)
+
+	_, err := simplejsonx.LoadReader(bytes.NewReader(data), simplejsonx.NewLoadConfig().WithMaxBytes(1024))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, simplejsonx.ErrLimitExceeded))
+
+	object, err := simplejsonx.LoadReader(bytes.NewReader(data), simplejsonx.NewLoadConfig().WithMaxBytes(int64(len(data))))
+	require.NoError(t, err)
+	require.Equal(t, "yyle88", object.Get("name").MustString())
+}
+
+func TestLoadWith_Strict(t *testing.T) {
+	testCases := []struct {
+		name   string
+		data   string
+		line   int
+		column int
+	}{
+		{"duplicate-key", "{\n  \"role\": \"user\",\n  \"role\": \"admin\"\n}", 3, 3},
+		{"invalid-utf8", "{\"name\": \"ab\xffc\"}", 1, 13},
+		{"lone-surrogate", `["ok", "\ud800x"]`, 1, 9},
+		{"trailing-data", "{\"a\": 1}\n{\"b\": 2}", 2, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			object, err := simplejsonx.Load([]byte(tc.data))
+			require.NoError(t, err) // lenient by default
+			require.NotNil(t, object)
+
+			_, err = simplejsonx.LoadWith([]byte(tc.data), simplejsonx.NewLoadConfig().WithStrict())
+			require.Error(t, err)
+			t.Log(err)
+
+			var syntaxError *simplejsonx.SyntaxError
+			require.True(t, errors.As(err, &syntaxError))
+			require.Equal(t, tc.line, syntaxError.Line)
+			require.Equal(t, tc.column, syntaxError.Column)
+		})
+	}
+}
+
+func TestLoadWith_StrictValid(t *testing.T) {
+	data := []byte("{\"name\": \"yyle88 \\ud83d\\ude00\", \"tags\": [\"a\"]}\n")
+
+	object, err := simplejsonx.LoadWith(data, simplejsonx.NewLoadConfig().WithStrict())
+	require.NoError(t, err)
+	require.Equal(t, "yyle88 \U0001F600", object.Get("name").MustString())
+}
+
+func TestLoadJSONC(t *testing.T) {
+	data := []byte(`// editor settings
+{
+	/* theme settings */
+	"theme": "dark", // trailing comment
+	"url": "http://example.com/a//b", /* not a comment inside string */
+	"rulers": [80, 120,],
+	"nested": {"a": 1,},
+}
+`)
+	object, err := simplejsonx.LoadJSONC(data)
+	require.NoError(t, err)
+
+	expected, err := simplejsonx.Load([]byte(`{"theme": "dark", "url": "http://example.com/a//b", "rulers": [80, 120], "nested": {"a": 1}}`))
+	require.NoError(t, err)
+	require.Equal(t, expected.Interface(), object.Interface())
+}
+
+func TestLoadJSONC_Invalid(t *testing.T) {
+	config := simplejsonx.NewLoadConfig().WithRelaxed().WithStrict()
+	for _, data := range []string{`[1,,]`, `{,}`, `[1 /* unterminated`, `{"a": 1} /`} {
+		_, err := simplejsonx.LoadWith([]byte(data), config)
+		require.Error(t, err, data)
+		t.Log(err)
+	}
+
+	_, err := simplejsonx.LoadWith([]byte(`{"a":1} /* never closed`), config)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unterminated block comment")
+
+	_, err = simplejsonx.LoadWith([]byte(`[1, 2] // trailing comment is not trailing data`), config)
+	require.NoError(t, err)
+
+	_, err = simplejsonx.LoadWith([]byte(`[1, 2,]`), simplejsonx.NewLoadConfig())
+	require.Error(t, err)
+	_, err = simplejsonx.Load([]byte(`// comment
+[1]`))
+	require.Error(t, err)
+}
+
+func TestLoadJSON5(t *testing.T) {
+	data := []byte(`// JSON5 sample
+{
+	unquoted: 'and you can quote me on that',
+	singleQuotes: 'I can use "double quotes" here',
+	lineBreaks: "Look, Mom! \
+No \\n's!",
+	hexadecimal: 0xdecaf,
+	leadingDecimalPoint: .8675309, andTrailing: 8675309.,
+	positiveSign: +1,
+	negativeHex: -0x10,
+	trailingComma: 'in objects', andIn: ['arrays',],
+	"backwardsCompatible": "with JSON",
+	$special_key1: null,
+}
+`)
+	object, err := simplejsonx.LoadJSON5(data)
+	require.NoError(t, err)
+
+	expected, err := simplejsonx.Load([]byte(`{
+	"unquoted": "and you can quote me on that",
+	"singleQuotes": "I can use \"double quotes\" here",
+	"lineBreaks": "Look, Mom! No \\n's!",
+	"hexadecimal": 912559,
+	"leadingDecimalPoint": 0.8675309, "andTrailing": 8675309,
+	"positiveSign": 1,
+	"negativeHex": -16,
+	"trailingComma": "in objects", "andIn": ["arrays"],
+	"backwardsCompatible": "with JSON",
+	"$special_key1": null
+}`))
+	require.NoError(t, err)
+	require.Equal(t, expected.Interface(), object.Interface())
+
+	hexadecimal, err := simplejsonx.Extract[int](object, "hexadecimal")
+	require.NoError(t, err)
+	require.Equal(t, 0xdecaf, hexadecimal)
+}
+
+func TestLoadJSON5_SpecialNumbers(t *testing.T) {
+	object, err := simplejsonx.LoadJSON5([]byte(`{pos: Infinity, neg: -Infinity, nan: NaN}`))
+	require.NoError(t, err)
+
+	pos, err := simplejsonx.Extract[float64](object, "pos")
+	require.NoError(t, err)
+	require.True(t, math.IsInf(pos, 1))
+
+	neg, err := simplejsonx.Extract[float64](object, "neg")
+	require.NoError(t, err)
+	require.True(t, math.IsInf(neg, -1))
+
+	nan, err := simplejsonx.Extract[float64](object, "nan")
+	require.NoError(t, err)
+	require.True(t, math.IsNaN(nan))
+}
+
+func TestLoadJSON5_Invalid(t *testing.T) {
+	for _, data := range []string{`{1a: 1}`, `[01]`, `[.]`, `['abc]`, `[0x]`, `["\1"]`, `{a b: 1}`} {
+		_, err := simplejsonx.LoadJSON5([]byte(data))
+		require.Error(t, err, data)
+		t.Log(err)
+	}
+}
@@ -0,0 +1,148 @@
+package simplejsonx
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+	"github.com/yyle88/simplejsonx/internal/utils"
+)
+
+// KeyMatch decides how object keys are compared with requested keys
+//
+// KeyMatch 决定对象键与请求的键如何比较
+type KeyMatch int
+
+const (
+	MatchExact      KeyMatch = iota // keys must be identical // 键必须完全相同
+	MatchFold                       // case-insensitive under Unicode case folding like strings.EqualFold, "UserID" matches "userid" // 按照 strings.EqualFold 的 Unicode 大小写折叠忽略大小写，"UserID" 匹配 "userid"
+	MatchConvention                 // ignores case, '_', '-' and spaces, "user_id" matches "userId" and "User-ID" // 忽略大小写、'_'、'-' 和空格，"user_id" 匹配 "userId" 和 "User-ID"
+)
+
+// ErrAmbiguousKey is reported when several keys of object match the requested key under KeyMatch
+//
+// ErrAmbiguousKey 在对象中有多个键在 KeyMatch 下与请求的键匹配时报告
+var ErrAmbiguousKey = errors.New("ambiguous key")
+
+// ExtractMatch works like Extract with keys compared under mode
+// An identical key always wins, otherwise several matching keys give ErrAmbiguousKey
+//
+// ExtractMatch 与 Extract 类似，但按照 mode 比较键
+// 完全相同的键始终优先，否则多个键同时匹配时返回 ErrAmbiguousKey
+func ExtractMatch[T any](object *simplejson.Json, key string, mode KeyMatch) (T, error) {
+	if object == nil {
+		return utils.Zero[T](), errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return utils.Zero[T](), errors.New("parameter key is missing")
+	}
+	value, _, exist, err := lookupKey(object, key, mode)
+	if err != nil {
+		return utils.Zero[T](), err
+	}
+	if !exist {
+		value = object.Get(key)
+	}
+	return Resolve[T](value)
+}
+
+// InspectMatch works like Inspect with keys compared under mode
+//
+// InspectMatch 与 Inspect 类似，但按照 mode 比较键
+func InspectMatch[T any](object *simplejson.Json, key string, mode KeyMatch) (T, error) {
+	res, _, err := InquireMatch[T](object, key, mode)
+	return res, err
+}
+
+// InquireMatch works like Inquire with keys compared under mode
+//
+// InquireMatch 与 Inquire 类似，但按照 mode 比较键
+func InquireMatch[T any](object *simplejson.Json, key string, mode KeyMatch) (T, bool, error) {
+	if object == nil {
+		return utils.Zero[T](), false, errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return utils.Zero[T](), false, errors.New("parameter key is missing")
+	}
+	value, _, exist, err := lookupKey(object, key, mode)
+	if err != nil || !exist {
+		return utils.Zero[T](), false, err
+	}
+	res, err := Resolve[T](value)
+	if err != nil {
+		return utils.Zero[T](), false, errors.WithMessage(err, "unable to resolve JSON value")
+	}
+	return res, true, nil
+}
+
+// ExploreMatch works like Explore with every path segment compared under mode
+//
+// ExploreMatch 与 Explore 类似，但路径的每一段都按照 mode 比较
+func ExploreMatch[T any](object *simplejson.Json, path string, mode KeyMatch) (T, bool, error) {
+	if object == nil {
+		return utils.Zero[T](), false, errors.New("parameter object is missing")
+	}
+	if path == "" {
+		return utils.Zero[T](), false, errors.New("parameter path is missing")
+	}
+	value := object
+	for _, key := range strings.Split(path, ".") {
+		var exist bool
+		var err error
+		if value, _, exist, err = lookupKey(value, key, mode); err != nil || !exist {
+			return utils.Zero[T](), false, err
+		}
+	}
+	res, err := Resolve[T](value)
+	if err != nil {
+		return utils.Zero[T](), false, errors.WithMessage(err, "unable to resolve JSON value")
+	}
+	return res, true, nil
+}
+
+// lookupKey finds the value whose key matches key under mode, returning the actual key found
+//
+// lookupKey 查找在 mode 下与 key 匹配的键对应的值，并返回实际找到的键
+func lookupKey(object *simplejson.Json, key string, mode KeyMatch) (*simplejson.Json, string, bool, error) {
+	if value, exist := object.CheckGet(key); exist || mode == MatchExact {
+		return value, key, exist, nil
+	}
+	fields, err := object.Map()
+	if err != nil {
+		return nil, "", false, nil
+	}
+	if mode == MatchConvention {
+		key = conventionSeparators.Replace(key)
+	}
+	var matches []string
+	for name := range fields {
+		if matchKey(name, key, mode) {
+			matches = append(matches, name)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, "", false, nil
+	case 1:
+		return Wrap(fields[matches[0]]), matches[0], true, nil
+	default:
+		sort.Strings(matches)
+		return nil, "", false, errors.WithMessagef(ErrAmbiguousKey, "key %q matches %q", key, matches)
+	}
+}
+
+// conventionSeparators strips the separators ignored by MatchConvention
+//
+// conventionSeparators 去除 MatchConvention 忽略的分隔符
+var conventionSeparators = strings.NewReplacer("_", "", "-", "", " ", "")
+
+// matchKey reports whether object key name matches key under mode, key is already stripped for MatchConvention
+//
+// matchKey 判断对象键 name 在 mode 下是否与 key 匹配，MatchConvention 时 key 已去除分隔符
+func matchKey(name string, key string, mode KeyMatch) bool {
+	if mode == MatchConvention {
+		name = conventionSeparators.Replace(name)
+	}
+	return strings.EqualFold(name, key)
+}
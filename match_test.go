@@ -0,0 +1,104 @@
+package simplejsonx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestExtractMatch(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"UserName": "yyle88", "user-age": 18, "IsRich": true}`))
+	require.NoError(t, err)
+
+	name, err := simplejsonx.ExtractMatch[string](object, "username", simplejsonx.MatchFold)
+	require.NoError(t, err)
+	require.Equal(t, "yyle88", name)
+
+	_, err = simplejsonx.ExtractMatch[string](object, "user_name", simplejsonx.MatchFold)
+	require.Error(t, err)
+
+	name, err = simplejsonx.ExtractMatch[string](object, "user_name", simplejsonx.MatchConvention)
+	require.NoError(t, err)
+	require.Equal(t, "yyle88", name)
+
+	age, err := simplejsonx.ExtractMatch[int](object, "userAge", simplejsonx.MatchConvention)
+	require.NoError(t, err)
+	require.Equal(t, 18, age)
+
+	_, err = simplejsonx.ExtractMatch[string](object, "username", simplejsonx.MatchExact)
+	require.Error(t, err)
+}
+
+func TestExtractMatch_Ambiguous(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"userId": 1, "user_id": 2, "UserId": 3}`))
+	require.NoError(t, err)
+
+	_, err = simplejsonx.ExtractMatch[int](object, "USER_ID", simplejsonx.MatchConvention)
+	require.ErrorIs(t, err, simplejsonx.ErrAmbiguousKey)
+
+	_, err = simplejsonx.ExtractMatch[int](object, "userid", simplejsonx.MatchFold)
+	require.ErrorIs(t, err, simplejsonx.ErrAmbiguousKey)
+
+	id, err := simplejsonx.ExtractMatch[int](object, "user_id", simplejsonx.MatchConvention)
+	require.NoError(t, err)
+	require.Equal(t, 2, id)
+}
+
+func TestExtractMatch_CaseFolding(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"ΟΔΟΣ": 1, "ſtatus_code": 2}`))
+	require.NoError(t, err)
+
+	value, err := simplejsonx.ExtractMatch[int](object, "οδος", simplejsonx.MatchFold) // final sigma folds to Σ, lowercasing gives σ
+	require.NoError(t, err)
+	require.Equal(t, 1, value)
+
+	value, err = simplejsonx.ExtractMatch[int](object, "StatusCode", simplejsonx.MatchConvention) // long s folds to S
+	require.NoError(t, err)
+	require.Equal(t, 2, value)
+}
+
+func TestInspectMatch(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"Nick_Name": "a"}`))
+	require.NoError(t, err)
+
+	name, err := simplejsonx.InspectMatch[string](object, "nickName", simplejsonx.MatchConvention)
+	require.NoError(t, err)
+	require.Equal(t, "a", name)
+
+	name, err = simplejsonx.InspectMatch[string](object, "nick", simplejsonx.MatchConvention)
+	require.NoError(t, err)
+	require.Equal(t, "", name)
+}
+
+func TestInquireMatch(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"AGE": 18}`))
+	require.NoError(t, err)
+
+	age, exist, err := simplejsonx.InquireMatch[int](object, "age", simplejsonx.MatchFold)
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, 18, age)
+
+	_, exist, err = simplejsonx.InquireMatch[string](object, "age", simplejsonx.MatchFold)
+	require.Error(t, err)
+	require.False(t, exist)
+
+	_, exist, err = simplejsonx.InquireMatch[int](object, "height", simplejsonx.MatchFold)
+	require.NoError(t, err)
+	require.False(t, exist)
+}
+
+func TestExploreMatch(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"Data": {"user_profile": {"FirstName": "a"}}}`))
+	require.NoError(t, err)
+
+	name, exist, err := simplejsonx.ExploreMatch[string](object, "data.userProfile.first_name", simplejsonx.MatchConvention)
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, "a", name)
+
+	_, exist, err = simplejsonx.ExploreMatch[string](object, "data.userProfile.first_name", simplejsonx.MatchFold)
+	require.NoError(t, err)
+	require.False(t, exist)
+}
@@ -0,0 +1,210 @@
+package simplejsonx
+
+import (
+	"strconv"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+	"github.com/yyle88/simplejsonx/internal/utils"
+)
+
+// Node wraps simplejson.Json for chained navigation with accumulated path and deferred error
+// Navigation never fails on the spot, the first problem is kept and reported by the terminal call
+// together with the full path, such as "data.items[3].price"
+// Node is a value type, each step returns new Node and leaves the receiver unchanged
+// Nodes taken from Document.Node also prefix errors with "source:line:column"
+//
+// Node 包装 simplejson.Json，支持链式导航，并累积路径和延迟报告错误
+// 导航过程不会立即失败，第一个问题会被保存，并在终端调用时连同完整路径一起报告
+// 例如 "data.items[3].price"
+// Node 是值类型，每一步都返回新的 Node，不会修改接收者
+// 从 Document.Node 得到的节点还会为错误添加 "source:line:column" 前缀
+type Node struct {
+	object   *simplejson.Json
+	path     Path
+	err      error
+	document *Document        // source positions, nil when not loaded via LoadDocument // 源位置，未通过 LoadDocument 加载时为 nil
+	parent   *simplejson.Json // container holding object, nil at root // 持有 object 的容器，根节点为 nil
+	member   string           // key or index of object inside parent // object 在 parent 中的键或下标
+	aliases  Aliases          // consulted by Get, nil without aliases // Get 时使用的别名表，没有别名时为 nil
+}
+
+// NewNode creates Node rooted at object
+//
+// NewNode 创建以 object 为根的 Node
+func NewNode(object *simplejson.Json) Node {
+	if object == nil {
+		return Node{err: errors.New("parameter object is missing")}
+	}
+	return Node{object: object}
+}
+
+// WithAliases returns Node whose Get steps, including those of later nodes, resolve keys through aliases
+//
+// WithAliases 返回新的 Node，其 Get（包括后续节点的 Get）会通过 aliases 解析键
+func (n Node) WithAliases(aliases Aliases) Node {
+	n.aliases = aliases
+	return n
+}
+
+// Get steps into the value at key of object node, typed maps such as those held by Wrap are supported too
+//
+// Get 进入对象节点中 key 处的值，也支持 Wrap 持有的具体类型映射
+func (n Node) Get(key string) Node {
+	if n.err != nil {
+		return n
+	}
+	path := appendPath(n.path, PathSegment{Key: key})
+	fields, ok := toGeneric(n.object.Interface()).(map[string]interface{})
+	if !ok {
+		return Node{path: path, err: n.annotate(errors.Errorf("%s: unable to get key of JSON %s", path, KindOf(n.object)))}
+	}
+	parent := Wrap(fields) // same map for generic objects, so source positions still apply
+	value, actual, exist := lookupAlias(parent, key, n.aliases)
+	if !exist {
+		return Node{path: path, err: n.document.Annotate(errors.Errorf("%s: key not found", path), parent, key)}
+	}
+	return Node{object: value, path: path, document: n.document, parent: parent, member: actual, aliases: n.aliases}
+}
+
+// Index steps into the element at index of array node, typed slices such as those held by Wrap are supported too
+//
+// Index 进入数组节点中 index 处的元素，也支持 Wrap 持有的具体类型切片
+func (n Node) Index(index int) Node {
+	if n.err != nil {
+		return n
+	}
+	path := appendPath(n.path, PathSegment{Index: index, IsIndex: true})
+	elements, ok := toGeneric(n.object.Interface()).([]interface{})
+	if !ok {
+		return Node{path: path, err: n.annotate(errors.Errorf("%s: unable to index JSON %s", path, KindOf(n.object)))}
+	}
+	if index < 0 || index >= len(elements) {
+		return Node{path: path, err: n.annotate(errors.Errorf("%s: index out of range with length %d", path, len(elements)))}
+	}
+	return Node{object: Wrap(elements[index]), path: path, document: n.document, parent: n.object, member: strconv.Itoa(index), aliases: n.aliases}
+}
+
+// Path steps along path written like "data.items[3].price", wildcards are not allowed
+//
+// Path 沿着形如 "data.items[3].price" 的路径前进，不允许使用通配符
+func (n Node) Path(path string) Node {
+	if n.err != nil {
+		return n
+	}
+	patterns, err := parsePathPattern(path)
+	if err != nil {
+		return Node{path: n.path, err: err}
+	}
+	res := n
+	for _, pattern := range patterns {
+		switch {
+		case pattern.any:
+			return Node{path: n.path, err: errors.Errorf("invalid path %q: wildcard is not allowed", path)}
+		case pattern.isIndex:
+			res = res.Index(pattern.index)
+		default:
+			res = res.Get(pattern.key)
+		}
+	}
+	return res
+}
+
+// Location returns the path accumulated so far
+//
+// Location 返回目前累积的路径
+func (n Node) Location() Path {
+	return n.path
+}
+
+// Err returns the deferred navigation error, nil when every step succeeded
+//
+// Err 返回延迟的导航错误，所有步骤都成功时返回 nil
+func (n Node) Err() error {
+	return n.err
+}
+
+// Exists reports whether every navigation step succeeded
+//
+// Exists 判断是否所有导航步骤都成功
+func (n Node) Exists() bool {
+	return n.err == nil
+}
+
+// Json returns the reached simplejson.Json or the deferred error
+//
+// Json 返回到达的 simplejson.Json 或延迟的错误
+func (n Node) Json() (*simplejson.Json, error) {
+	return As[*simplejson.Json](n)
+}
+
+// Int returns the reached value as int
+//
+// Int 以 int 返回到达的值
+func (n Node) Int() (int, error) {
+	return As[int](n)
+}
+
+// Int64 returns the reached value as int64
+//
+// Int64 以 int64 返回到达的值
+func (n Node) Int64() (int64, error) {
+	return As[int64](n)
+}
+
+// Uint64 returns the reached value as uint64
+//
+// Uint64 以 uint64 返回到达的值
+func (n Node) Uint64() (uint64, error) {
+	return As[uint64](n)
+}
+
+// Float64 returns the reached value as float64
+//
+// Float64 以 float64 返回到达的值
+func (n Node) Float64() (float64, error) {
+	return As[float64](n)
+}
+
+// String returns the reached value as string
+//
+// String 以 string 返回到达的值
+func (n Node) String() (string, error) {
+	return As[string](n)
+}
+
+// Bool returns the reached value as bool
+//
+// Bool 以 bool 返回到达的值
+func (n Node) Bool() (bool, error) {
+	return As[bool](n)
+}
+
+// As converts the value reached by node via Resolve
+// Returns the deferred navigation error, or conversion error prefixed with the node path
+//
+// As 通过 Resolve 转换 node 到达的值
+// 返回延迟的导航错误，或带有节点路径前缀的转换错误
+func As[T any](node Node) (T, error) {
+	if node.err != nil {
+		return utils.Zero[T](), node.err
+	}
+	res, err := Resolve[T](node.object)
+	if err != nil {
+		if len(node.path) != 0 {
+			err = errors.WithMessage(err, node.path.String())
+		}
+		return utils.Zero[T](), node.annotate(err)
+	}
+	return res, nil
+}
+
+// annotate prefixes err with source position of the reached value when node comes from Document
+//
+// annotate 当节点来自 Document 时为 err 添加所到达值的源位置前缀
+func (n Node) annotate(err error) error {
+	if n.parent == nil {
+		return n.document.Annotate(err, n.object, "")
+	}
+	return n.document.Annotate(err, n.parent, n.member)
+}
@@ -0,0 +1,95 @@
+package simplejsonx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+const nodeData = `{"data": {"items": [{"price": 3}, {"price": 5, "name": "b", "ok": true}], "rate": 0.5}}`
+
+func TestNode(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(nodeData))
+	require.NoError(t, err)
+	root := simplejsonx.NewNode(object)
+
+	price, err := root.Get("data").Get("items").Index(1).Get("price").Int()
+	require.NoError(t, err)
+	require.Equal(t, 5, price)
+
+	name, err := root.Path("data.items[1].name").String()
+	require.NoError(t, err)
+	require.Equal(t, "b", name)
+
+	ok, err := root.Path("data.items[1]").Get("ok").Bool()
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	rate, err := root.Path("data.rate").Float64()
+	require.NoError(t, err)
+	require.Equal(t, 0.5, rate)
+
+	items, err := simplejsonx.As[[]interface{}](root.Path("data.items"))
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	node := root.Path("data.items[0]")
+	require.True(t, node.Exists())
+	require.Equal(t, "data.items[0]", node.Location().String())
+}
+
+func TestNode_DeferredError(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(nodeData))
+	require.NoError(t, err)
+	root := simplejsonx.NewNode(object)
+
+	node := root.Get("data").Get("items").Index(3).Get("price")
+	require.False(t, node.Exists())
+	_, err = node.Int()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "data.items[3]")
+	require.Contains(t, err.Error(), "out of range")
+
+	_, err = root.Path("data.missing.price").Int()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "data.missing")
+
+	_, err = root.Path("data.rate").Index(0).Int()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "data.rate[0]")
+
+	_, err = root.Path("data.items[0].price").String()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "data.items[0].price")
+
+	_, err = root.Path("data.items[*]").Int()
+	require.Error(t, err)
+
+	_, err = simplejsonx.NewNode(nil).Get("data").Int()
+	require.Error(t, err)
+}
+
+func TestNode_WrappedValues(t *testing.T) {
+	root := simplejsonx.NewNode(simplejsonx.Wrap(map[string]interface{}{
+		"tags":   []string{"a", "b"},
+		"counts": map[string]int{"x": 1},
+		"data":   []byte("raw"),
+	}))
+
+	tag, err := root.Get("tags").Index(0).String()
+	require.NoError(t, err)
+	require.Equal(t, "a", tag)
+
+	count, err := root.Path("counts.x").Int()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	_, err = root.Get("tags").Index(2).String()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "out of range with length 2")
+
+	_, err = root.Get("data").Index(0).String()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unable to index JSON string")
+}
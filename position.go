@@ -0,0 +1,287 @@
+package simplejsonx
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+	"github.com/yyle88/simplejsonx/internal/jsonparse"
+	"github.com/yyle88/simplejsonx/internal/utils"
+)
+
+// Document binds JSON tree with what its accessors consult: source positions and alias table
+// Documents from LoadDocument remember where each value came from in the source, errors reported
+// through them name the offending value as "source:line:column", such as "config.json:14:9"
+// Aliases attached via WithAliases are consulted by ExtractIn, InspectIn, InquireIn, ExploreIn and Node
+// Positions belong to Document, they are released together with it and never shared between documents
+//
+// Document 将 JSON 树与其访问函数所使用的信息绑定在一起：源位置和别名表
+// 通过 LoadDocument 得到的文档记住每个值在源文本中的位置，通过其报告的错误
+// 会以 "source:line:column" 指出出问题的值，例如 "config.json:14:9"
+// 通过 WithAliases 附加的别名会被 ExtractIn、InspectIn、InquireIn、ExploreIn 和 Node 使用
+// 位置信息属于 Document，随其一起释放，不会在文档之间共享
+type Document struct {
+	object     *simplejson.Json
+	source     *sourceDocument
+	containers map[uintptr]*containerPositions
+	aliases    Aliases
+}
+
+type sourceDocument struct {
+	source     string
+	data       []byte
+	lineStarts []int
+}
+
+type containerPositions struct {
+	container interface{} // held so its address never maps to a different container // 持有容器，使其地址不会指向其它容器
+	offset    int
+	keys      map[string]int
+	elems     []int
+}
+
+// NewDocument creates Document around object without source positions, such as to attach aliases
+//
+// NewDocument 创建包装 object 且不带源位置的 Document，例如用于附加别名
+func NewDocument(object *simplejson.Json) *Document {
+	if object == nil {
+		object = simplejson.New()
+	}
+	return &Document{object: object}
+}
+
+// LoadDocument parses data using given config and records the position of every value
+// source names the input in error prefixes, nil config behaves like Load
+//
+// LoadDocument 使用给定配置解析数据，并记录每个值的位置
+// source 是错误前缀中使用的输入名称，配置为 nil 时与 Load 行为相同
+func LoadDocument(data []byte, source string, config *LoadConfig) (*Document, error) {
+	if config == nil {
+		config = NewLoadConfig()
+	}
+	if config.MaxBytes > 0 && int64(len(data)) > config.MaxBytes {
+		return nil, errors.WithMessage(&LimitError{Limit: "bytes", Max: config.MaxBytes, Offset: config.MaxBytes}, "unable to parse JSON")
+	}
+	document := &Document{
+		source:     &sourceDocument{source: source, data: data, lineStarts: []int{0}},
+		containers: map[uintptr]*containerPositions{},
+	}
+	parseConfig := config.parseConfig()
+	parseConfig.OnContainer = func(positions *jsonparse.Positions) {
+		if pointer, ok := containerPointer(positions.Container); ok {
+			document.containers[pointer] = &containerPositions{
+				container: positions.Container,
+				offset:    positions.Offset,
+				keys:      positions.Keys,
+				elems:     positions.Elems,
+			}
+		}
+	}
+	value, err := jsonparse.Parse(data, parseConfig)
+	if err != nil {
+		return nil, errors.WithMessage(err, "unable to parse JSON")
+	}
+	for idx, c := range data {
+		if c == '\n' {
+			document.source.lineStarts = append(document.source.lineStarts, idx+1)
+		}
+	}
+	document.object = Wrap(value)
+	return document, nil
+}
+
+// Json returns the root of the document
+//
+// Json 返回文档的根
+func (d *Document) Json() *simplejson.Json {
+	return d.object
+}
+
+// Node returns Node rooted at the document, its navigation and conversion errors carry source positions
+//
+// Node 返回以文档为根的 Node，其导航和转换错误带有源位置
+func (d *Document) Node() Node {
+	return Node{object: d.object, document: d, aliases: d.aliases}
+}
+
+// WithAliases returns Document sharing tree and positions with d whose accessors resolve keys through aliases
+// The tree is never modified, d itself keeps its own alias table
+//
+// WithAliases 返回与 d 共享树和位置信息的 Document，其访问函数通过 aliases 解析键
+// 不会修改树结构，d 自身保留原有的别名表
+func (d *Document) WithAliases(aliases Aliases) *Document {
+	res := *d
+	res.aliases = aliases
+	return &res
+}
+
+// Annotate prefixes err with "source:line:column" of the member at key inside parent
+// Falls back to position of parent itself when key is absent, returns err unchanged
+// when parent does not belong to the document, nil err stays nil
+// ExtractIn, InspectIn, InquireIn and ExploreIn apply it automatically
+//
+// Annotate 为 err 添加 parent 中 key 对应成员的 "source:line:column" 前缀
+// 当 key 不存在时使用 parent 自身的位置，parent 不属于该文档时原样返回 err，err 为 nil 时仍返回 nil
+// ExtractIn、InspectIn、InquireIn 和 ExploreIn 会自动应用它
+func (d *Document) Annotate(err error, parent *simplejson.Json, key string) error {
+	if err == nil || d == nil || parent == nil {
+		return err
+	}
+	pointer, ok := containerPointer(parent.Interface())
+	if !ok {
+		return err
+	}
+	positions, ok := d.containers[pointer]
+	if !ok {
+		return err
+	}
+	offset := positions.offset
+	if valueOffset, ok := positions.keys[key]; ok {
+		offset = valueOffset
+	} else if index, convErr := strconv.Atoi(key); convErr == nil && index >= 0 && index < len(positions.elems) {
+		offset = positions.elems[index]
+	}
+	return errors.WithMessage(err, d.source.location(offset))
+}
+
+// ExtractIn works like Extract on object taken from document, with key resolved through aliases of document
+// Errors start with "source:line:column" when document was loaded with positions
+//
+// ExtractIn 与 Extract 类似，作用于从 document 中取出的 object，并通过 document 的别名解析键
+// 当 document 带有位置信息时，错误以 "source:line:column" 开头
+func ExtractIn[T any](document *Document, object *simplejson.Json, key string) (T, error) {
+	if document == nil {
+		return utils.Zero[T](), errors.New("parameter document is missing")
+	}
+	if object == nil {
+		return utils.Zero[T](), errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return utils.Zero[T](), errors.New("parameter key is missing")
+	}
+	value, actual, exist := lookupAlias(object, key, document.aliases)
+	if !exist {
+		value, actual = object.Get(key), key
+	}
+	res, err := Resolve[T](value)
+	if err != nil {
+		return utils.Zero[T](), document.Annotate(err, object, actual)
+	}
+	return res, nil
+}
+
+// InspectIn works like Inspect on object taken from document, with key resolved through aliases of document
+// Errors start with "source:line:column" when document was loaded with positions
+//
+// InspectIn 与 Inspect 类似，作用于从 document 中取出的 object，并通过 document 的别名解析键
+// 当 document 带有位置信息时，错误以 "source:line:column" 开头
+func InspectIn[T any](document *Document, object *simplejson.Json, key string) (T, error) {
+	res, _, err := InquireIn[T](document, object, key)
+	return res, err
+}
+
+// InquireIn works like Inquire on object taken from document, with key resolved through aliases of document
+// Errors start with "source:line:column" when document was loaded with positions
+//
+// InquireIn 与 Inquire 类似，作用于从 document 中取出的 object，并通过 document 的别名解析键
+// 当 document 带有位置信息时，错误以 "source:line:column" 开头
+func InquireIn[T any](document *Document, object *simplejson.Json, key string) (T, bool, error) {
+	if document == nil {
+		return utils.Zero[T](), false, errors.New("parameter document is missing")
+	}
+	if object == nil {
+		return utils.Zero[T](), false, errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return utils.Zero[T](), false, errors.New("parameter key is missing")
+	}
+	value, actual, exist := lookupAlias(object, key, document.aliases)
+	if !exist {
+		return utils.Zero[T](), false, nil
+	}
+	res, err := Resolve[T](value)
+	if err != nil {
+		return utils.Zero[T](), false, document.Annotate(errors.WithMessage(err, "unable to resolve JSON value"), object, actual)
+	}
+	return res, true, nil
+}
+
+// ExploreIn works like Explore from the root of document, with every path segment resolved through aliases of document
+// Errors start with "source:line:column" when document was loaded with positions
+//
+// ExploreIn 与 Explore 类似，从 document 的根开始查找，路径的每一段都通过 document 的别名解析
+// 当 document 带有位置信息时，错误以 "source:line:column" 开头
+func ExploreIn[T any](document *Document, path string) (T, bool, error) {
+	if document == nil {
+		return utils.Zero[T](), false, errors.New("parameter document is missing")
+	}
+	if path == "" {
+		return utils.Zero[T](), false, errors.New("parameter path is missing")
+	}
+	parent, value := document.object, document.object
+	var actual string
+	for _, key := range strings.Split(path, ".") {
+		var exist bool
+		parent = value
+		if value, actual, exist = lookupAlias(parent, key, document.aliases); !exist {
+			return utils.Zero[T](), false, nil
+		}
+	}
+	res, err := Resolve[T](value)
+	if err != nil {
+		return utils.Zero[T](), false, document.Annotate(errors.WithMessage(err, "unable to resolve JSON value"), parent, actual)
+	}
+	return res, true, nil
+}
+
+// containerPointer returns identity of generic containers, empty arrays have no identity
+//
+// containerPointer 返回通用容器的标识，空数组没有标识
+func containerPointer(value interface{}) (uintptr, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return reflect.ValueOf(v).Pointer(), true
+	case []interface{}:
+		if len(v) == 0 {
+			return 0, false
+		}
+		return reflect.ValueOf(v).Pointer(), true
+	default:
+		return 0, false
+	}
+}
+
+// location formats offset as "source:line:column" in the style of compilers
+//
+// location 将偏移量格式化为编译器风格的 "source:line:column"
+func (d *sourceDocument) location(offset int) string {
+	line := sort.Search(len(d.lineStarts), func(i int) bool {
+		return d.lineStarts[i] > offset
+	})
+	column := utf8.RuneCount(d.data[d.lineStarts[line-1]:offset]) + 1
+	res := strconv.Itoa(line) + ":" + strconv.Itoa(column)
+	if d.source != "" {
+		res = d.source + ":" + res
+	}
+	return res
+}
+
+// withSyntaxPosition converts encoding/json syntax errors into SyntaxError with line, column and snippet
+//
+// withSyntaxPosition 将 encoding/json 的语法错误转换成带行号、列号和上下文片段的 SyntaxError
+func withSyntaxPosition(data []byte, err error) error {
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		return err
+	}
+	offset := int(syntaxError.Offset) - 1 // encoding/json reports offset after the offending byte
+	if offset < 0 {
+		offset = 0
+	}
+	return jsonparse.NewSyntaxError(data, offset, syntaxError.Error())
+}
@@ -0,0 +1,169 @@
+package simplejsonx_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestLoad_SyntaxErrorPosition(t *testing.T) {
+	data := []byte("{\n  \"name\": \"yyle88\",\n  \"age\": 18x\n}")
+
+	_, err := simplejsonx.Load(data)
+	require.Error(t, err)
+	t.Log(err)
+
+	var syntaxError *simplejsonx.SyntaxError
+	require.True(t, errors.As(err, &syntaxError))
+	require.Equal(t, 3, syntaxError.Line)
+	require.Equal(t, 12, syntaxError.Column)
+	require.Equal(t, `  "age": 18x`, syntaxError.Snippet)
+}
+
+func TestLoadWith_SyntaxErrorPosition(t *testing.T) {
+	data := []byte("[\n  1,\n  2,,\n]")
+
+	_, err := simplejsonx.LoadWith(data, simplejsonx.NewLoadConfig())
+	require.Error(t, err)
+	t.Log(err)
+
+	var syntaxError *simplejsonx.SyntaxError
+	require.True(t, errors.As(err, &syntaxError))
+	require.Equal(t, 3, syntaxError.Line)
+	require.Equal(t, 5, syntaxError.Column)
+}
+
+func TestLoadDocument(t *testing.T) {
+	data := []byte(`{
+  "server": {
+    "host": "localhost",
+    "port": "8080"
+  },
+  "workers": [1, "two", 3]
+}`)
+	document, err := simplejsonx.LoadDocument(data, "config.json", simplejsonx.NewLoadConfig())
+	require.NoError(t, err)
+
+	{
+		_, err := document.Node().Path("server.port").Int()
+		require.Error(t, err)
+		t.Log(err)
+		require.Contains(t, err.Error(), "config.json:4:13: server.port: ")
+	}
+	{
+		_, err := document.Node().Get("server").Get("timeout").Int()
+		require.Error(t, err)
+		t.Log(err)
+		require.Contains(t, err.Error(), "config.json:2:13: server.timeout: key not found")
+	}
+	{
+		_, err := document.Node().Path("workers[1]").Int()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "config.json:6:18: ")
+
+		_, err = document.Node().Get("workers").String()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "config.json:6:14: ")
+
+		_, err = document.Node().Index(0).Int()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "config.json:1:1: ")
+	}
+	{
+		server := document.Json().Get("server")
+		_, err := simplejsonx.ExtractIn[int](document, server, "port")
+		require.Error(t, err)
+		t.Log(err)
+		require.Contains(t, err.Error(), "config.json:4:13: ")
+
+		_, err = simplejsonx.Extract[int](server, "port")
+		require.Error(t, err)
+		require.Contains(t, document.Annotate(err, server, "port").Error(), "config.json:4:13: ")
+
+		require.NoError(t, document.Annotate(nil, server, "port"))
+	}
+	{
+		port, err := document.Node().Path("server.port").String()
+		require.NoError(t, err)
+		require.Equal(t, "8080", port)
+	}
+}
+
+func TestExploreIn(t *testing.T) {
+	data := []byte(`{
+  "server": {
+    "host": "localhost",
+    "port": "8080"
+  },
+  "workers": [1, "two", 3]
+}`)
+	document, err := simplejsonx.LoadDocument(data, "config.json", nil)
+	require.NoError(t, err)
+
+	_, _, err = simplejsonx.ExploreIn[int](document, "server.host")
+	require.Error(t, err)
+	t.Log(err)
+	require.Contains(t, err.Error(), "config.json:3:13: ")
+
+	port, exist, err := simplejsonx.ExploreIn[string](document, "server.port")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, "8080", port)
+
+	_, exist, err = simplejsonx.ExploreIn[int](document, "server.timeout")
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	_, err = simplejsonx.ExtractIn[int](document, document.Json().Get("server"), "timeout")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "config.json:2:13: ")
+
+	_, err = simplejsonx.ExtractIn[string](document, document.Json(), "workers")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "config.json:6:14: ")
+
+	_, exist, err = simplejsonx.InquireIn[int](document, document.Json().Get("server"), "port")
+	require.Error(t, err)
+	require.False(t, exist)
+	require.Contains(t, err.Error(), "config.json:4:13: ")
+
+	value, err := simplejsonx.InspectIn[int](document, document.Json().Get("server"), "timeout")
+	require.NoError(t, err)
+	require.Equal(t, 0, value)
+}
+
+func TestLoadDocument_ChildOutlivesRoot(t *testing.T) {
+	document, err := simplejsonx.LoadDocument([]byte("{\n  \"server\": {\"port\": true}\n}"), "", nil)
+	require.NoError(t, err)
+
+	server := document.Node().Get("server")
+	document = nil
+	runtime.GC()
+
+	_, err = server.Get("port").Int()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "2:22: server.port: ")
+}
+
+func TestLoadDocument_Invalid(t *testing.T) {
+	_, err := simplejsonx.LoadDocument([]byte(`{"a": 1,}`), "config.json", nil)
+	require.Error(t, err)
+
+	_, err = simplejsonx.LoadDocument([]byte(`{"a": 1,}`), "config.json", simplejsonx.NewLoadConfig().WithRelaxed())
+	require.NoError(t, err)
+
+	_, err = simplejsonx.LoadDocument([]byte(`[1, 2]`), "config.json", simplejsonx.NewLoadConfig().WithMaxBytes(3))
+	require.ErrorIs(t, err, simplejsonx.ErrLimitExceeded)
+}
+
+func TestLoadWith_WithoutPositions(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"port": "8080"}`))
+	require.NoError(t, err)
+
+	_, err = simplejsonx.Extract[int](object, "port")
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), ":1:")
+}
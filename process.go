@@ -0,0 +1,179 @@
+package simplejsonx
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// ProcessLines reads NDJSON records from reader and runs fn on them with a pool of workers
+// Lines are parsed by the workers with the LoadConfig of reader, blank and malformed lines follow
+// its LineMode settings, so LineCollect ones end up in reader.Errors and LineStop ones stop the run
+// Results of fn are passed to emit in input order together with the 1-based line number,
+// fn failures reach emit as per-line errors without stopping the run
+// Returning error from emit stops processing, the same as cancelling ctx
+// Returns only after every worker has exited, fn is never called once ProcessLines returns
+// Cancelling returns at once even when reading blocks, the pending read then finishes in background,
+// so reader must not be used again after a cancelled or stopped run
+// workers <= 0 uses GOMAXPROCS
+//
+// ProcessLines 从 reader 中读取 NDJSON 记录，并使用工作协程池对其执行 fn
+// 各行由工作协程按照 reader 的 LoadConfig 解析，空行和格式错误的行遵循其 LineMode 设置，
+// 因此 LineCollect 的问题会记录到 reader.Errors 中，LineStop 的问题会中止处理
+// fn 的结果按输入顺序连同从 1 开始的行号一起传给 emit，fn 的失败会作为逐行错误传给 emit，不会中止处理
+// emit 返回错误时停止处理，效果与取消 ctx 相同
+// 所有工作协程退出后才会返回，ProcessLines 返回后不会再调用 fn
+// 即使读取处于阻塞状态，取消后也会立即返回，未完成的读取随后在后台结束，
+// 因此被取消或中止的处理之后不能再使用 reader
+// workers <= 0 时使用 GOMAXPROCS
+func ProcessLines[R any](ctx context.Context, reader *LineReader, workers int, fn func(object *simplejson.Json) (R, error), emit func(line int, res R, err error) error) error {
+	if ctx == nil {
+		return errors.New("parameter ctx is missing")
+	}
+	if reader == nil {
+		return errors.New("parameter reader is missing")
+	}
+	if fn == nil || emit == nil {
+		return errors.New("parameter fn and emit are required")
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	stopCtx, cancel := context.WithCancel(ctx)
+
+	type lineJob struct {
+		seq  int
+		line int
+		data []byte
+	}
+	type lineResult struct {
+		seq   int
+		line  int
+		value R
+		err   error
+		mode  *LineMode // set when the line is blank or malformed // 空行或格式错误的行时设置
+	}
+	jobs := make(chan lineJob)
+	results := make(chan lineResult, workers)
+	window := make(chan struct{}, workers*4) // bounds records held out of order
+	readDone := make(chan struct{})
+	var readErr error
+
+	go func() {
+		defer close(readDone)
+		defer close(jobs)
+		for seq := 0; ; seq++ {
+			data, err := reader.readLine()
+			if err != nil {
+				if err != io.EOF {
+					readErr = err
+				}
+				return
+			}
+			select {
+			case window <- struct{}{}:
+			case <-stopCtx.Done():
+				return
+			}
+			select {
+			case jobs <- lineJob{seq: seq, line: reader.line, data: data}:
+			case <-stopCtx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for idx := 0; idx < workers; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				var job lineJob
+				var ok bool
+				select {
+				case job, ok = <-jobs:
+				case <-stopCtx.Done():
+					return
+				}
+				if !ok {
+					return
+				}
+				res := lineResult{seq: job.seq, line: job.line}
+				if len(job.data) == 0 {
+					res.err, res.mode = errBlankLine, &reader.config.BlankLines
+				} else if object, err := reader.parse(job.data); err != nil {
+					res.err, res.mode = err, &reader.config.MalformedLines
+				} else if stopCtx.Err() != nil {
+					return
+				} else {
+					res.value, res.err = fn(object)
+				}
+				select {
+				case results <- res:
+				case <-stopCtx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	defer func() {
+		cancel()
+		for range results { // closed once wg.Wait returns
+		}
+	}()
+
+	pending := make(map[int]lineResult)
+	next := 0
+	for {
+		var res lineResult
+		var ok bool
+		select {
+		case res, ok = <-results:
+		case <-stopCtx.Done():
+			return ctx.Err()
+		}
+		if !ok {
+			break
+		}
+		pending[res.seq] = res
+		for {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			current, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			<-window
+			if current.mode != nil {
+				if !reader.handle(current.line, *current.mode, current.err) {
+					return reader.err
+				}
+				continue
+			}
+			if err := emit(current.line, current.value, current.err); err != nil {
+				return err
+			}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	<-readDone
+	if readErr != nil {
+		reader.err = readErr
+		return readErr
+	}
+	return nil
+}
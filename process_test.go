@@ -0,0 +1,165 @@
+package simplejsonx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestProcessLines(t *testing.T) {
+	var builder strings.Builder
+	for idx := 1; idx <= 200; idx++ {
+		builder.WriteString(fmt.Sprintf("{\"id\": %d, \"price\": %d}\n", idx, idx*10))
+		if idx%50 == 0 {
+			builder.WriteString("\n")
+		}
+	}
+
+	var ids []int
+	err := simplejsonx.ProcessLines(context.Background(), simplejsonx.NewLineReader(strings.NewReader(builder.String())), 8,
+		func(object *simplejson.Json) (int, error) {
+			id, err := simplejsonx.Extract[int](object, "id")
+			if err != nil {
+				return 0, err
+			}
+			time.Sleep(time.Duration(id%3) * time.Millisecond) // finish out of order
+			return id, nil
+		},
+		func(line int, id int, err error) error {
+			require.NoError(t, err)
+			ids = append(ids, id)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Len(t, ids, 200)
+	for idx, id := range ids {
+		require.Equal(t, idx+1, id)
+	}
+}
+
+func TestProcessLines_LineErrors(t *testing.T) {
+	data := "{\"id\": 1}\n{\"id\": \n{\"id\": \"x\"}\n{\"id\": 4}\n"
+
+	reader := simplejsonx.NewLineReaderWith(strings.NewReader(data), simplejsonx.NewLineReaderConfig().WithMalformedLines(simplejsonx.LineCollect))
+	var lines []int
+	var failed []int
+	err := simplejsonx.ProcessLines(context.Background(), reader, 2,
+		func(object *simplejson.Json) (int, error) {
+			return simplejsonx.Extract[int](object, "id")
+		},
+		func(line int, id int, err error) error {
+			lines = append(lines, line)
+			if err != nil {
+				failed = append(failed, line)
+			}
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 3, 4}, lines)
+	require.Equal(t, []int{3}, failed)
+	require.Len(t, reader.Errors(), 1)
+	require.Equal(t, 2, reader.Errors()[0].Line)
+
+	err = simplejsonx.ProcessLines(context.Background(), simplejsonx.NewLineReader(strings.NewReader(data)), 2,
+		func(object *simplejson.Json) (int, error) {
+			return simplejsonx.Extract[int](object, "id")
+		},
+		func(line int, id int, err error) error {
+			require.Less(t, line, 2)
+			return nil
+		})
+	var lineError *simplejsonx.LineError
+	require.ErrorAs(t, err, &lineError)
+	require.Equal(t, 2, lineError.Line)
+}
+
+func TestProcessLines_StopAndCancel(t *testing.T) {
+	data := strings.Repeat("{\"id\": 1}\n", 1000)
+	parse := func(object *simplejson.Json) (int, error) {
+		return simplejsonx.Extract[int](object, "id")
+	}
+
+	stopErr := errors.New("stop")
+	var count int
+	err := simplejsonx.ProcessLines(context.Background(), simplejsonx.NewLineReader(strings.NewReader(data)), 4, parse,
+		func(line int, id int, err error) error {
+			count++
+			if count == 10 {
+				return stopErr
+			}
+			return nil
+		})
+	require.ErrorIs(t, err, stopErr)
+	require.Equal(t, 10, count)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err = simplejsonx.ProcessLines(ctx, simplejsonx.NewLineReader(strings.NewReader(data)), 4, parse,
+		func(line int, id int, err error) error {
+			if line == 5 {
+				cancel()
+			}
+			return nil
+		})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestProcessLines_NoCallsAfterReturn(t *testing.T) {
+	data := strings.Repeat("{\"id\": 1}\n", 1000)
+
+	var calls atomic.Int64
+	stopErr := errors.New("stop")
+	err := simplejsonx.ProcessLines(context.Background(), simplejsonx.NewLineReader(strings.NewReader(data)), 8,
+		func(object *simplejson.Json) (int, error) {
+			calls.Add(1)
+			time.Sleep(time.Millisecond)
+			return simplejsonx.Extract[int](object, "id")
+		},
+		func(line int, id int, err error) error {
+			if line == 3 {
+				return stopErr
+			}
+			return nil
+		})
+	require.ErrorIs(t, err, stopErr)
+
+	count := calls.Load()
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, count, calls.Load())
+}
+
+func TestProcessLines_CancelBlockedReader(t *testing.T) {
+	pipeReader, pipeWriter := io.Pipe()
+	defer func() { _ = pipeWriter.Close() }()
+	go func() {
+		_, _ = pipeWriter.Write([]byte("{\"id\": 1}\n")) // then stays open without more data
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- simplejsonx.ProcessLines(ctx, simplejsonx.NewLineReader(pipeReader), 4,
+			func(object *simplejson.Json) (int, error) {
+				return simplejsonx.Extract[int](object, "id")
+			},
+			func(line int, id int, err error) error {
+				cancel()
+				return nil
+			})
+	}()
+
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(2 * time.Second):
+		t.Fatal("ProcessLines is still blocked after cancel")
+	}
+}
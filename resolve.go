@@ -12,6 +12,8 @@
 package simplejsonx
 
 import (
+	"bytes"
+	"encoding/json"
 	"strings"
 
 	"github.com/bitly/go-simplejson"
@@ -62,12 +64,14 @@ func Inspect[T any](object *simplejson.Json, key string) (T, error) {
 // Handles primitives (int, int64, float64, string, uint64, bool)
 // Handles arrays ([]string, []interface{}, []*simplejson.Json)
 // Handles complex types (map[string]interface{}, []byte, *simplejson.Json)
+// Handles json.RawMessage by re-encoding any node, objects and arrays included
 //
 // Resolve 提取 JSON 值并转换成目标类型
 // 支持使用 simplejson.Json 方法进行全面的类型转换
 // 处理基础类型（int、int64、float64、string、uint64、bool）
 // 处理数组类型（[]string、[]interface{}、[]*simplejson.Json）
 // 处理复杂类型（map[string]interface{}、[]byte、*simplejson.Json）
+// 处理 json.RawMessage，可将包括对象和数组在内的任意节点重新编码
 func Resolve[T any](object *simplejson.Json) (T, error) {
 	if object == nil {
 		return utils.Zero[T](), errors.New("parameter object is missing")
@@ -133,6 +137,12 @@ func Resolve[T any](object *simplejson.Json) (T, error) {
 			return zero, errors.WithMessage(err, "unable to resolve JSON value to []byte")
 		}
 		return any(res).(T), nil
+	case json.RawMessage:
+		res, err := encodeRaw(object)
+		if err != nil {
+			return zero, errors.WithMessage(err, "unable to resolve JSON value to json.RawMessage")
+		}
+		return any(res).(T), nil
 	case *simplejson.Json:
 		return any(object).(T), nil
 	case []*simplejson.Json:
@@ -167,6 +177,40 @@ func GetList(object *simplejson.Json, key string) (objects []*simplejson.Json, e
 	return List(elements), nil
 }
 
+// ExtractRaw retrieves the value at the specified key re-encoded as json.RawMessage
+// Works on any node kind, suited to handing sub-tree to another decoder or storing it verbatim
+// Returns errors when key is missing, unlike Resolve which encodes missing value as null
+//
+// ExtractRaw 检索指定键的值并重新编码成 json.RawMessage
+// 适用于任意类型的节点，便于将子树交给其他解码器或原样存储
+// 当键缺失时返回错误，而 Resolve 会把缺失的值编码成 null
+func ExtractRaw(object *simplejson.Json, key string) (json.RawMessage, error) {
+	if object == nil {
+		return nil, errors.New("parameter object is missing")
+	}
+	if key == "" {
+		return nil, errors.New("parameter key is missing")
+	}
+	value, exist := object.CheckGet(key)
+	if !exist {
+		return nil, errors.Errorf("unable to find key %q in JSON object", key)
+	}
+	return Resolve[json.RawMessage](value)
+}
+
+// encodeRaw encodes JSON value compactly without HTML escaping so text stays verbatim
+//
+// encodeRaw 以紧凑格式编码 JSON 值且不转义 HTML 字符，保持文本原样
+func encodeRaw(object *simplejson.Json) (json.RawMessage, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(object.Interface()); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
 // Inquire queries JSON object at the specified key with tri-state result pattern
 // Returns parsed value, existence boolean, and possible conversion errors
 // Distinguishes between missing keys and type conversion failures
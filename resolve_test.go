@@ -240,6 +240,38 @@ func TestResolve_Bytes(t *testing.T) {
 	require.Equal(t, "abc", string(res))
 }
 
+func TestResolve_RawMessage(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"user": {"name": "a<b", "tags": [1, 2.5]}, "count": 3}`))
+	require.NoError(t, err)
+
+	res, err := simplejsonx.Resolve[json.RawMessage](object.Get("user"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name": "a<b", "tags": [1, 2.5]}`, string(res))
+	require.Contains(t, string(res), "a<b")
+
+	res, err = simplejsonx.Resolve[json.RawMessage](object.Get("count"))
+	require.NoError(t, err)
+	require.Equal(t, `3`, string(res))
+}
+
+func TestExtractRaw(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"items": [{"id": 1}, {"id": 2}]}`))
+	require.NoError(t, err)
+
+	res, err := simplejsonx.ExtractRaw(object, "items")
+	require.NoError(t, err)
+	require.Equal(t, `[{"id":1},{"id":2}]`, string(res))
+
+	var items []struct {
+		ID int `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(res, &items))
+	require.Len(t, items, 2)
+
+	_, err = simplejsonx.ExtractRaw(object, "missing")
+	require.Error(t, err)
+}
+
 func TestGetList(t *testing.T) {
 	jsonData := `{
 		"key": [
@@ -417,3 +449,37 @@ func TestExploreGetList(t *testing.T) {
 		t.Log(item)
 	}
 }
+
+func newBenchmarkListObject(b *testing.B, size int) *simplejson.Json {
+	var buffer bytes.Buffer
+	buffer.WriteString(`{"items": [`)
+	for idx := 0; idx < size; idx++ {
+		if idx > 0 {
+			buffer.WriteByte(',')
+		}
+		buffer.WriteString(`{"id": 1}`)
+	}
+	buffer.WriteString(`]}`)
+	object, err := simplejsonx.Load(buffer.Bytes())
+	require.NoError(b, err)
+	return object
+}
+
+func BenchmarkGetList(b *testing.B) {
+	object := newBenchmarkListObject(b, 100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for idx := 0; idx < b.N; idx++ {
+		_, _ = simplejsonx.GetList(object, "items")
+	}
+}
+
+func BenchmarkGetList_WrapEach(b *testing.B) {
+	object := newBenchmarkListObject(b, 100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for idx := 0; idx < b.N; idx++ {
+		elements, _ := object.Get("items").Array()
+		_ = listByWrap(elements)
+	}
+}
@@ -0,0 +1,239 @@
+package simplejsonx
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// StreamPaths reads JSON from reader incrementally and calls fn on every value matching one of paths
+// Paths use dot-separated keys with array indexes, such as "data.records[*]" or "meta.items[0].name"
+// Use "*" to match any key and "[*]" to match any index, empty path matches the whole document
+// Only matched values are decoded, everything else is skipped token by token to keep memory bounded
+// fn receives the concrete path of each value, such as "data.records[3]", returning error stops the stream
+//
+// StreamPaths 增量读取 reader 中的 JSON，并对匹配任一路径的值调用 fn
+// 路径使用点分隔的键和数组下标，例如 "data.records[*]" 或 "meta.items[0].name"
+// 使用 "*" 匹配任意键，使用 "[*]" 匹配任意下标，空路径匹配整个文档
+// 只会解码匹配的值，其余内容按词法单元跳过，以保持内存占用有界
+// fn 接收每个值的具体路径，例如 "data.records[3]"，返回错误时停止读取
+func StreamPaths(r io.Reader, paths []string, fn func(path string, value *simplejson.Json) error) error {
+	if r == nil {
+		return errors.New("parameter reader is missing")
+	}
+	if fn == nil {
+		return errors.New("parameter fn is missing")
+	}
+	patterns := make([][]pathPattern, 0, len(paths))
+	for _, path := range paths {
+		pattern, err := parsePathPattern(path)
+		if err != nil {
+			return err
+		}
+		patterns = append(patterns, pattern)
+	}
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	s := &streamer{decoder: decoder, patterns: patterns, fn: fn}
+	if err := s.visit(Path{}); err != nil {
+		if err == io.EOF {
+			return errors.New("unable to stream JSON: unexpected end of input")
+		}
+		return err
+	}
+	return nil
+}
+
+// StreamEach reads JSON from reader incrementally and converts values matching path via Resolve
+// Suited to iterating one big array, such as "data.records[*]", without loading the whole document
+//
+// StreamEach 增量读取 reader 中的 JSON，并通过 Resolve 转换匹配路径的值
+// 适合在不加载整个文档的情况下遍历一个大数组，例如 "data.records[*]"
+func StreamEach[T any](r io.Reader, path string, fn func(value T) error) error {
+	if fn == nil {
+		return errors.New("parameter fn is missing")
+	}
+	return StreamPaths(r, []string{path}, func(match string, value *simplejson.Json) error {
+		res, err := Resolve[T](value)
+		if err != nil {
+			return errors.WithMessagef(err, "unable to resolve JSON value at %s", match)
+		}
+		return fn(res)
+	})
+}
+
+// pathPattern is one segment of a streaming path pattern
+//
+// pathPattern 是流式路径模式中的一个片段
+type pathPattern struct {
+	key     string
+	index   int
+	isIndex bool
+	any     bool
+}
+
+func parsePathPattern(path string) ([]pathPattern, error) {
+	if path == "" || path == "$" {
+		return nil, nil
+	}
+	var patterns []pathPattern
+	for _, part := range strings.Split(path, ".") {
+		name := part
+		if idx := strings.IndexByte(part, '['); idx >= 0 {
+			name = part[:idx]
+		}
+		if name == "*" {
+			patterns = append(patterns, pathPattern{any: true})
+		} else if name != "" {
+			patterns = append(patterns, pathPattern{key: name})
+		} else if len(name) == len(part) {
+			return nil, errors.Errorf("invalid path %q: empty key", path)
+		}
+		for rest := part[len(name):]; rest != ""; {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 {
+				return nil, errors.Errorf("invalid path %q: malformed index in %q", path, part)
+			}
+			switch text := rest[1:end]; text {
+			case "*":
+				patterns = append(patterns, pathPattern{isIndex: true, any: true})
+			default:
+				index, err := strconv.Atoi(text)
+				if err != nil || index < 0 {
+					return nil, errors.Errorf("invalid path %q: bad index %q", path, text)
+				}
+				patterns = append(patterns, pathPattern{isIndex: true, index: index})
+			}
+			rest = rest[end+1:]
+		}
+	}
+	return patterns, nil
+}
+
+func (p pathPattern) match(segment PathSegment) bool {
+	if p.isIndex != segment.IsIndex {
+		return false
+	}
+	if p.any {
+		return true
+	}
+	if p.isIndex {
+		return p.index == segment.Index
+	}
+	return p.key == segment.Key
+}
+
+type streamer struct {
+	decoder  *json.Decoder
+	patterns [][]pathPattern
+	fn       func(path string, value *simplejson.Json) error
+}
+
+// classify reports whether path matches a pattern exactly, or is a prefix of some pattern
+//
+// classify 判断 path 是否完全匹配某个模式，或是某个模式的前缀
+func (s *streamer) classify(path Path) (exact bool, prefix bool) {
+	for _, pattern := range s.patterns {
+		if len(path) > len(pattern) {
+			continue
+		}
+		matched := true
+		for idx, segment := range path {
+			if !pattern[idx].match(segment) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if len(path) == len(pattern) {
+			return true, true
+		}
+		prefix = true
+	}
+	return false, prefix
+}
+
+// visit handles the value starting at the next token located at path
+//
+// visit 处理位于 path 处、从下一个词法单元开始的值
+func (s *streamer) visit(path Path) error {
+	exact, prefix := s.classify(path)
+	if exact {
+		var value interface{}
+		if err := s.decoder.Decode(&value); err != nil {
+			return s.wrapError(err)
+		}
+		return s.fn(path.String(), Wrap(value))
+	}
+	token, err := s.decoder.Token()
+	if err != nil {
+		return s.wrapError(err)
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return nil // scalar outside every pattern
+	}
+	if !prefix {
+		return s.skip(delim)
+	}
+	switch delim {
+	case '{':
+		for s.decoder.More() {
+			token, err := s.decoder.Token()
+			if err != nil {
+				return s.wrapError(err)
+			}
+			key, _ := token.(string)
+			if err := s.visit(appendPath(path, PathSegment{Key: key})); err != nil {
+				return err
+			}
+		}
+	case '[':
+		for index := 0; s.decoder.More(); index++ {
+			if err := s.visit(appendPath(path, PathSegment{Index: index, IsIndex: true})); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := s.decoder.Token(); err != nil { // closing delimiter
+		return s.wrapError(err)
+	}
+	return nil
+}
+
+// skip consumes tokens until the container opened by delim is closed
+//
+// skip 消费词法单元，直到 delim 打开的容器被关闭
+func (s *streamer) skip(delim json.Delim) error {
+	if delim != '{' && delim != '[' {
+		return nil
+	}
+	for depth := 1; depth > 0; {
+		token, err := s.decoder.Token()
+		if err != nil {
+			return s.wrapError(err)
+		}
+		if d, ok := token.(json.Delim); ok {
+			switch d {
+			case '{', '[':
+				depth++
+			default:
+				depth--
+			}
+		}
+	}
+	return nil
+}
+
+func (s *streamer) wrapError(err error) error {
+	if err == io.EOF {
+		return err
+	}
+	return errors.WithMessagef(err, "unable to stream JSON at offset %d", s.decoder.InputOffset())
+}
@@ -0,0 +1,98 @@
+package simplejsonx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestStreamPaths(t *testing.T) {
+	data := `{
+		"meta": {"total": 3, "tags": ["a", "b"]},
+		"skip": {"deep": [[1, 2], {"x": [3]}]},
+		"data": {"records": [
+			{"id": 1, "name": "a"},
+			{"id": 2, "name": "b"},
+			{"id": 3, "name": "c"}
+		]}
+	}`
+
+	var paths []string
+	var names []string
+	err := simplejsonx.StreamPaths(strings.NewReader(data), []string{"meta.total", "data.records[*].name", "meta.tags[1]"},
+		func(path string, value *simplejson.Json) error {
+			paths = append(paths, path)
+			if strings.HasSuffix(path, ".name") {
+				names = append(names, value.MustString())
+			}
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, []string{"meta.total", "meta.tags[1]", "data.records[0].name", "data.records[1].name", "data.records[2].name"}, paths)
+	require.Equal(t, []string{"a", "b", "c"}, names)
+}
+
+func TestStreamPaths_Wildcard(t *testing.T) {
+	data := `{"a": {"v": 1}, "b": {"v": 2}, "c": {"w": 3}}`
+
+	var paths []string
+	err := simplejsonx.StreamPaths(strings.NewReader(data), []string{"*.v"}, func(path string, value *simplejson.Json) error {
+		paths = append(paths, path)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.v", "b.v"}, paths)
+
+	var root *simplejson.Json
+	err = simplejsonx.StreamPaths(strings.NewReader(data), []string{""}, func(path string, value *simplejson.Json) error {
+		require.Equal(t, "", path)
+		root = value
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, root.MustMap(), 3)
+}
+
+func TestStreamPaths_Errors(t *testing.T) {
+	fn := func(path string, value *simplejson.Json) error { return nil }
+
+	err := simplejsonx.StreamPaths(strings.NewReader(`{}`), []string{"a[x]"}, fn)
+	require.Error(t, err)
+
+	err = simplejsonx.StreamPaths(strings.NewReader(`{"a": [1, 2`), []string{"a[*]"}, fn)
+	require.Error(t, err)
+
+	stopErr := errors.New("stop")
+	var count int
+	err = simplejsonx.StreamPaths(strings.NewReader(`[1, 2, 3, 4]`), []string{"[*]"}, func(path string, value *simplejson.Json) error {
+		count++
+		if count == 2 {
+			return stopErr
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, stopErr)
+	require.Equal(t, 2, count)
+}
+
+func TestStreamEach(t *testing.T) {
+	data := `{"data": {"records": [{"id": 1}, {"id": 2}, {"id": 3}]}}`
+
+	var ids []int
+	err := simplejsonx.StreamEach(strings.NewReader(data), "data.records[*].id", func(id int) error {
+		ids = append(ids, id)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, ids)
+
+	err = simplejsonx.StreamEach(strings.NewReader(data), "data.records[*]", func(id int) error {
+		return nil
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "data.records[0]")
+}
@@ -0,0 +1,21 @@
+package simplejsonm
+
+import (
+	"hash"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func Canonical(object *simplejson.Json) []byte {
+	res0, err := simplejsonx.Canonical(object)
+	sure.Must(err)
+	return res0
+}
+
+func Fingerprint(object *simplejson.Json, h hash.Hash) []byte {
+	res0, err := simplejsonx.Fingerprint(object, h)
+	sure.Must(err)
+	return res0
+}
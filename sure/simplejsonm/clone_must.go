@@ -0,0 +1,23 @@
+package simplejsonm
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func Clone(object *simplejson.Json) *simplejson.Json {
+	res0 := simplejsonx.Clone(object)
+	return res0
+}
+
+func ListClone(elements []interface{}) (objects []*simplejson.Json) {
+	objects = simplejsonx.ListClone(elements)
+	return objects
+}
+
+func GetListClone(object *simplejson.Json, key string) (objects []*simplejson.Json) {
+	objects, err := simplejsonx.GetListClone(object, key)
+	sure.Must(err)
+	return objects
+}
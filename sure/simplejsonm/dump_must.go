@@ -0,0 +1,31 @@
+package simplejsonm
+
+import (
+	"io"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func NewDumpConfig() *simplejsonx.DumpConfig {
+	res0 := simplejsonx.NewDumpConfig()
+	return res0
+}
+
+func Dump(object *simplejson.Json) []byte {
+	res0, err := simplejsonx.Dump(object)
+	sure.Must(err)
+	return res0
+}
+
+func DumpWith(object *simplejson.Json, config *simplejsonx.DumpConfig) []byte {
+	res0, err := simplejsonx.DumpWith(object, config)
+	sure.Must(err)
+	return res0
+}
+
+func DumpTo(w io.Writer, object *simplejson.Json, config *simplejsonx.DumpConfig) {
+	err := simplejsonx.DumpTo(w, object, config)
+	sure.Must(err)
+}
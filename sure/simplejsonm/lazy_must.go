@@ -0,0 +1,24 @@
+package simplejsonm
+
+import (
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func NewLazy(data []byte) *simplejsonx.LazyDocument {
+	res0, err := simplejsonx.NewLazy(data)
+	sure.Must(err)
+	return res0
+}
+
+func LazyExtract[T any](document *simplejsonx.LazyDocument, key string) T {
+	res0, err := simplejsonx.LazyExtract[T](document, key)
+	sure.Must(err)
+	return res0
+}
+
+func LazyExplore[T any](document *simplejsonx.LazyDocument, path string) (T, bool) {
+	res0, res1, err := simplejsonx.LazyExplore[T](document, path)
+	sure.Must(err)
+	return res0, res1
+}
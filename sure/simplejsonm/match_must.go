@@ -0,0 +1,31 @@
+package simplejsonm
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func ExtractMatch[T any](object *simplejson.Json, key string, mode simplejsonx.KeyMatch) T {
+	res0, err := simplejsonx.ExtractMatch[T](object, key, mode)
+	sure.Must(err)
+	return res0
+}
+
+func InspectMatch[T any](object *simplejson.Json, key string, mode simplejsonx.KeyMatch) T {
+	res0, err := simplejsonx.InspectMatch[T](object, key, mode)
+	sure.Must(err)
+	return res0
+}
+
+func InquireMatch[T any](object *simplejson.Json, key string, mode simplejsonx.KeyMatch) (T, bool) {
+	res0, res1, err := simplejsonx.InquireMatch[T](object, key, mode)
+	sure.Must(err)
+	return res0, res1
+}
+
+func ExploreMatch[T any](object *simplejson.Json, path string, mode simplejsonx.KeyMatch) (T, bool) {
+	res0, res1, err := simplejsonx.ExploreMatch[T](object, path, mode)
+	sure.Must(err)
+	return res0, res1
+}
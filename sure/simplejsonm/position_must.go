@@ -0,0 +1,42 @@
+package simplejsonm
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func NewDocument(object *simplejson.Json) *simplejsonx.Document {
+	res0 := simplejsonx.NewDocument(object)
+	return res0
+}
+
+func LoadDocument(data []byte, source string, config *simplejsonx.LoadConfig) *simplejsonx.Document {
+	res0, err := simplejsonx.LoadDocument(data, source, config)
+	sure.Must(err)
+	return res0
+}
+
+func ExtractIn[T any](document *simplejsonx.Document, object *simplejson.Json, key string) T {
+	res0, err := simplejsonx.ExtractIn[T](document, object, key)
+	sure.Must(err)
+	return res0
+}
+
+func InspectIn[T any](document *simplejsonx.Document, object *simplejson.Json, key string) T {
+	res0, err := simplejsonx.InspectIn[T](document, object, key)
+	sure.Must(err)
+	return res0
+}
+
+func InquireIn[T any](document *simplejsonx.Document, object *simplejson.Json, key string) (T, bool) {
+	res0, res1, err := simplejsonx.InquireIn[T](document, object, key)
+	sure.Must(err)
+	return res0, res1
+}
+
+func ExploreIn[T any](document *simplejsonx.Document, path string) (T, bool) {
+	res0, res1, err := simplejsonx.ExploreIn[T](document, path)
+	sure.Must(err)
+	return res0, res1
+}
@@ -0,0 +1,14 @@
+package simplejsonm
+
+import (
+	"context"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func ProcessLines[R any](ctx context.Context, reader *simplejsonx.LineReader, workers int, fn func(object *simplejson.Json) (R, error), emit func(line int, res R, err error) error) {
+	err := simplejsonx.ProcessLines[R](ctx, reader, workers, fn, emit)
+	sure.Must(err)
+}
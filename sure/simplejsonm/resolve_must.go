@@ -1,6 +1,8 @@
 package simplejsonm
 
 import (
+	"encoding/json"
+
 	"github.com/bitly/go-simplejson"
 	"github.com/yyle88/simplejsonx"
 	"github.com/yyle88/sure"
@@ -30,6 +32,12 @@ func GetList(object *simplejson.Json, key string) (objects []*simplejson.Json) {
 	return objects
 }
 
+func ExtractRaw(object *simplejson.Json, key string) json.RawMessage {
+	res0, err := simplejsonx.ExtractRaw(object, key)
+	sure.Must(err)
+	return res0
+}
+
 func Inquire[T any](object *simplejson.Json, key string) (T, bool) {
 	res0, res1, err := simplejsonx.Inquire[T](object, key)
 	sure.Must(err)
@@ -0,0 +1,12 @@
+package simplejsonm
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func Walk(object *simplejson.Json, fn simplejsonx.WalkFunc) {
+	err := simplejsonx.Walk(object, fn)
+	sure.Must(err)
+}
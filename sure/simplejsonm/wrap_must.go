@@ -17,6 +17,12 @@ func Wrap(value interface{}) (object *simplejson.Json) {
 	return object
 }
 
+func WrapNormalized(value interface{}) (object *simplejson.Json) {
+	object, err := simplejsonx.WrapNormalized(value)
+	sure.Must(err)
+	return object
+}
+
 func List(elements []interface{}) (objects []*simplejson.Json) {
 	objects = simplejsonx.List(elements)
 	return objects
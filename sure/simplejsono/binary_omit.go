@@ -0,0 +1,73 @@
+package simplejsono
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func ResolveBase64(object *simplejson.Json) []byte {
+	res0, err := simplejsonx.ResolveBase64(object)
+	sure.Omit(err)
+	return res0
+}
+
+func ResolveHex(object *simplejson.Json) []byte {
+	res0, err := simplejsonx.ResolveHex(object)
+	sure.Omit(err)
+	return res0
+}
+
+func ResolveBase32(object *simplejson.Json) []byte {
+	res0, err := simplejsonx.ResolveBase32(object)
+	sure.Omit(err)
+	return res0
+}
+
+func ExtractBase64(object *simplejson.Json, key string) []byte {
+	res0, err := simplejsonx.ExtractBase64(object, key)
+	sure.Omit(err)
+	return res0
+}
+
+func ExtractHex(object *simplejson.Json, key string) []byte {
+	res0, err := simplejsonx.ExtractHex(object, key)
+	sure.Omit(err)
+	return res0
+}
+
+func ExtractBase32(object *simplejson.Json, key string) []byte {
+	res0, err := simplejsonx.ExtractBase32(object, key)
+	sure.Omit(err)
+	return res0
+}
+
+func WrapBase64(data []byte) *simplejson.Json {
+	res0 := simplejsonx.WrapBase64(data)
+	return res0
+}
+
+func WrapHex(data []byte) *simplejson.Json {
+	res0 := simplejsonx.WrapHex(data)
+	return res0
+}
+
+func WrapBase32(data []byte) *simplejson.Json {
+	res0 := simplejsonx.WrapBase32(data)
+	return res0
+}
+
+func SetBase64(object *simplejson.Json, key string, data []byte) {
+	err := simplejsonx.SetBase64(object, key, data)
+	sure.Omit(err)
+}
+
+func SetHex(object *simplejson.Json, key string, data []byte) {
+	err := simplejsonx.SetHex(object, key, data)
+	sure.Omit(err)
+}
+
+func SetBase32(object *simplejson.Json, key string, data []byte) {
+	err := simplejsonx.SetBase32(object, key, data)
+	sure.Omit(err)
+}
@@ -0,0 +1,43 @@
+package simplejsono
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func Map[T any, R any](object *simplejson.Json, path string, fn func(v T) (R, error)) []R {
+	res0, err := simplejsonx.Map[T, R](object, path, fn)
+	sure.Omit(err)
+	return res0
+}
+
+func Filter[T any](object *simplejson.Json, path string, fn func(v T) (bool, error)) []T {
+	res0, err := simplejsonx.Filter[T](object, path, fn)
+	sure.Omit(err)
+	return res0
+}
+
+func Reduce[T any, A any](object *simplejson.Json, path string, init A, fn func(acc A, v T) (A, error)) A {
+	res0, err := simplejsonx.Reduce[T, A](object, path, init, fn)
+	sure.Omit(err)
+	return res0
+}
+
+func GroupBy[T any, K comparable](object *simplejson.Json, path string, fn func(v T) (K, error)) map[K][]T {
+	res0, err := simplejsonx.GroupBy[T, K](object, path, fn)
+	sure.Omit(err)
+	return res0
+}
+
+func SortBy[T any](object *simplejson.Json, path string, less func(a, b T) bool) []T {
+	res0, err := simplejsonx.SortBy[T](object, path, less)
+	sure.Omit(err)
+	return res0
+}
+
+func Distinct[T comparable](object *simplejson.Json, path string) []T {
+	res0, err := simplejsonx.Distinct[T](object, path)
+	sure.Omit(err)
+	return res0
+}
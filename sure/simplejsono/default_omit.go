@@ -0,0 +1,25 @@
+package simplejsono
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func ExtractOr[T any](object *simplejson.Json, key string, def T) T {
+	res0, err := simplejsonx.ExtractOr[T](object, key, def)
+	sure.Omit(err)
+	return res0
+}
+
+func InspectOr[T any](object *simplejson.Json, key string, def T) T {
+	res0, err := simplejsonx.InspectOr[T](object, key, def)
+	sure.Omit(err)
+	return res0
+}
+
+func ExploreOr[T any](object *simplejson.Json, path string, def T) T {
+	res0, err := simplejsonx.ExploreOr[T](object, path, def)
+	sure.Omit(err)
+	return res0
+}
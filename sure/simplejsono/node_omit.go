@@ -0,0 +1,18 @@
+package simplejsono
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func NewNode(object *simplejson.Json) simplejsonx.Node {
+	res0 := simplejsonx.NewNode(object)
+	return res0
+}
+
+func As[T any](node simplejsonx.Node) T {
+	res0, err := simplejsonx.As[T](node)
+	sure.Omit(err)
+	return res0
+}
@@ -0,0 +1,19 @@
+package simplejsons
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func ExtractFirst[T any](object *simplejson.Json, keys ...string) (T, string) {
+	res0, res1, err := simplejsonx.ExtractFirst[T](object, keys...)
+	sure.Soft(err)
+	return res0, res1
+}
+
+func ExploreFirst[T any](object *simplejson.Json, paths ...string) (T, string) {
+	res0, res1, err := simplejsonx.ExploreFirst[T](object, paths...)
+	sure.Soft(err)
+	return res0, res1
+}
@@ -0,0 +1,17 @@
+package simplejsons
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func Each[T any](object *simplejson.Json, key string, fn func(i int, v T) error) {
+	err := simplejsonx.Each[T](object, key, fn)
+	sure.Soft(err)
+}
+
+func EachField[T any](object *simplejson.Json, fn func(key string, v T) error) {
+	err := simplejsonx.EachField[T](object, fn)
+	sure.Soft(err)
+}
@@ -0,0 +1,31 @@
+package simplejsons
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+)
+
+func NewEqualConfig() *simplejsonx.EqualConfig {
+	res0 := simplejsonx.NewEqualConfig()
+	return res0
+}
+
+func Equal(a, b *simplejson.Json) bool {
+	res0 := simplejsonx.Equal(a, b)
+	return res0
+}
+
+func EqualWith(a, b *simplejson.Json, config *simplejsonx.EqualConfig) bool {
+	res0 := simplejsonx.EqualWith(a, b, config)
+	return res0
+}
+
+func Contains(super, sub *simplejson.Json) bool {
+	res0 := simplejsonx.Contains(super, sub)
+	return res0
+}
+
+func ContainsWith(super, sub *simplejson.Json, config *simplejsonx.EqualConfig) bool {
+	res0 := simplejsonx.ContainsWith(super, sub, config)
+	return res0
+}
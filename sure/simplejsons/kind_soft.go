@@ -0,0 +1,34 @@
+package simplejsons
+
+import (
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func KindOf(object *simplejson.Json) simplejsonx.Kind {
+	res0 := simplejsonx.KindOf(object)
+	return res0
+}
+
+func IsNull(object *simplejson.Json) bool {
+	res0 := simplejsonx.IsNull(object)
+	return res0
+}
+
+func Len(object *simplejson.Json) int {
+	res0, err := simplejsonx.Len(object)
+	sure.Soft(err)
+	return res0
+}
+
+func Keys(object *simplejson.Json) []string {
+	res0, err := simplejsonx.Keys(object)
+	sure.Soft(err)
+	return res0
+}
+
+func Has(object *simplejson.Json, path string) bool {
+	res0 := simplejsonx.Has(object, path)
+	return res0
+}
@@ -0,0 +1,32 @@
+package simplejsons
+
+import (
+	"io"
+
+	"github.com/yyle88/simplejsonx"
+)
+
+func NewLineReaderConfig() *simplejsonx.LineReaderConfig {
+	res0 := simplejsonx.NewLineReaderConfig()
+	return res0
+}
+
+func NewLineReader(r io.Reader) *simplejsonx.LineReader {
+	res0 := simplejsonx.NewLineReader(r)
+	return res0
+}
+
+func NewLineReaderWith(r io.Reader, config *simplejsonx.LineReaderConfig) *simplejsonx.LineReader {
+	res0 := simplejsonx.NewLineReaderWith(r, config)
+	return res0
+}
+
+func NewLineWriter(w io.Writer) *simplejsonx.LineWriter {
+	res0 := simplejsonx.NewLineWriter(w)
+	return res0
+}
+
+func NewLineWriterWith(w io.Writer, config *simplejsonx.DumpConfig) *simplejsonx.LineWriter {
+	res0 := simplejsonx.NewLineWriterWith(w, config)
+	return res0
+}
@@ -0,0 +1,38 @@
+package simplejsons
+
+import (
+	"io"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func NewLoadConfig() *simplejsonx.LoadConfig {
+	res0 := simplejsonx.NewLoadConfig()
+	return res0
+}
+
+func LoadWith(data []byte, config *simplejsonx.LoadConfig) (object *simplejson.Json) {
+	object, err := simplejsonx.LoadWith(data, config)
+	sure.Soft(err)
+	return object
+}
+
+func LoadJSONC(data []byte) (object *simplejson.Json) {
+	object, err := simplejsonx.LoadJSONC(data)
+	sure.Soft(err)
+	return object
+}
+
+func LoadJSON5(data []byte) (object *simplejson.Json) {
+	object, err := simplejsonx.LoadJSON5(data)
+	sure.Soft(err)
+	return object
+}
+
+func LoadReader(r io.Reader, config *simplejsonx.LoadConfig) (object *simplejson.Json) {
+	object, err := simplejsonx.LoadReader(r, config)
+	sure.Soft(err)
+	return object
+}
@@ -0,0 +1,19 @@
+package simplejsons
+
+import (
+	"io"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/yyle88/simplejsonx"
+	"github.com/yyle88/sure"
+)
+
+func StreamPaths(r io.Reader, paths []string, fn func(path string, value *simplejson.Json) error) {
+	err := simplejsonx.StreamPaths(r, paths, fn)
+	sure.Soft(err)
+}
+
+func StreamEach[T any](r io.Reader, path string, fn func(value T) error) {
+	err := simplejsonx.StreamEach[T](r, path, fn)
+	sure.Soft(err)
+}
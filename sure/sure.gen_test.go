@@ -1,8 +1,13 @@
 package sure
 
 import (
+	"bytes"
+	"go/format"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/yyle88/runpath"
 	"github.com/yyle88/sure"
 	"github.com/yyle88/sure/sure_pkg_gen"
@@ -18,4 +23,43 @@ func TestGen(t *testing.T) {
 	sure_pkg_gen.GenerateSurePackageFiles(t, sure_pkg_gen.NewSurePackageConfig(srcRoot, sure.SOFT, pkgPath).WithOutputRoot(outRoot).WithNewPkgName("simplejsons"))
 	sure_pkg_gen.GenerateSurePackageFiles(t, sure_pkg_gen.NewSurePackageConfig(srcRoot, sure.MUST, pkgPath).WithOutputRoot(outRoot).WithNewPkgName("simplejsonm"))
 	sure_pkg_gen.GenerateSurePackageFiles(t, sure_pkg_gen.NewSurePackageConfig(srcRoot, sure.OMIT, pkgPath).WithOutputRoot(outRoot).WithNewPkgName("simplejsono"))
+	fixVariadicParams(t, outRoot, "simplejsons", "simplejsonm", "simplejsono")
+}
+
+// variadicParams lists the variadic functions whose wrappers sure_pkg_gen v0.0.40 generates wrongly
+// The generator declares them as "keys ... ...string", so the output fails to parse, is left unformatted
+// and misses the go-simplejson import. Drop this list and fixVariadicParams once the generator is fixed
+//
+// variadicParams 列出 sure_pkg_gen v0.0.40 生成错误包装函数的可变参数函数
+// 生成器将其声明为 "keys ... ...string"，导致输出无法解析、未被格式化且缺少 go-simplejson 导入
+// 生成器修复后删除该列表和 fixVariadicParams
+var variadicParams = map[string]map[string]string{
+	"alias": {"ExtractFirst": "keys", "ExploreFirst": "paths"},
+}
+
+// fixVariadicParams repairs the declarations listed in variadicParams, leaving other files untouched
+// Fails when a listed declaration is not found, so changes of the generator are noticed
+//
+// fixVariadicParams 修复 variadicParams 中列出的声明，不会改动其它文件
+// 当找不到列出的声明时失败，以便察觉生成器的变化
+func fixVariadicParams(t *testing.T, outRoot string, pkgNames ...string) {
+	suffixes := map[string]string{"simplejsons": "soft", "simplejsonm": "must", "simplejsono": "omit"}
+	for _, pkgName := range pkgNames {
+		for name, params := range variadicParams {
+			path := filepath.Join(outRoot, pkgName, name+"_"+suffixes[pkgName]+".go")
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			for function, param := range params {
+				broken := []byte("func " + function + "[T any](object *simplejson.Json," + param + " ... ...string)")
+				require.Equal(t, 1, bytes.Count(data, broken), "generator output changed: %s %s", path, function)
+				data = bytes.Replace(data, broken, []byte("func "+function+"[T any](object *simplejson.Json, "+param+" ...string)"), 1)
+			}
+			if !bytes.Contains(data, []byte(`"github.com/bitly/go-simplejson"`)) {
+				data = bytes.Replace(data, []byte("import("), []byte("import(\n\"github.com/bitly/go-simplejson\""), 1)
+			}
+			code, err := format.Source(data)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, code, 0644))
+		}
+	}
 }
@@ -0,0 +1,121 @@
+package simplejsonx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+)
+
+// PathSegment is one step of Path, either object key or array index
+//
+// PathSegment 是 Path 中的一步，可以是对象键或数组下标
+type PathSegment struct {
+	Key     string // object key when IsIndex is false // IsIndex 为 false 时的对象键
+	Index   int    // array index when IsIndex is true // IsIndex 为 true 时的数组下标
+	IsIndex bool   // whether segment addresses array element // 该片段是否指向数组元素
+}
+
+// Path locates a node from the root, empty Path means the root itself
+//
+// Path 从根节点开始定位一个节点，空 Path 表示根节点本身
+type Path []PathSegment
+
+// String formats path like "data.items[3].price"
+// Keys that are empty or contain '.', '[', ']' or '"' are written quoted, like `data["a.b"]`
+//
+// String 将路径格式化为类似 "data.items[3].price" 的形式
+// 为空或包含 '.'、'['、']'、'"' 的键会加引号书写，例如 `data["a.b"]`
+func (p Path) String() string {
+	var builder strings.Builder
+	for idx, segment := range p {
+		switch {
+		case segment.IsIndex:
+			builder.WriteString("[" + strconv.Itoa(segment.Index) + "]")
+		case segment.Key == "" || strings.ContainsAny(segment.Key, ".[]\""):
+			builder.WriteString("[" + strconv.Quote(segment.Key) + "]")
+		default:
+			if idx > 0 {
+				builder.WriteByte('.')
+			}
+			builder.WriteString(segment.Key)
+		}
+	}
+	return builder.String()
+}
+
+// WalkAction tells Walk how to continue after visiting a node
+//
+// WalkAction 告诉 Walk 访问节点之后如何继续
+type WalkAction int
+
+const (
+	WalkContinue WalkAction = iota // descend into the node's children // 继续进入该节点的子节点
+	WalkSkip                       // skip the node's children // 跳过该节点的子节点
+	WalkStop                       // stop the whole walk // 停止整个遍历
+)
+
+// WalkFunc visits one node with its path and kind
+// path is freshly allocated for every node and can be retained
+//
+// WalkFunc 访问一个节点，并接收其路径和类型
+// 每个节点的 path 都是新分配的，可以保留使用
+type WalkFunc func(path Path, node *simplejson.Json, kind Kind) WalkAction
+
+// Walk visits every node depth-first, parents before children, object keys in sorted order
+// Nodes share data with the document, so changing maps and slices through them edits the document
+// Typed Go containers from Wrap are walked through read-only generic copies
+//
+// Walk 以深度优先方式访问每个节点，先父节点后子节点，对象键按排序顺序访问
+// 节点与文档共享数据，因此通过节点修改映射和切片会修改文档本身
+// 来自 Wrap 的具体类型 Go 容器会通过只读的通用副本进行遍历
+func Walk(object *simplejson.Json, fn WalkFunc) error {
+	if object == nil {
+		return errors.New("parameter object is missing")
+	}
+	if fn == nil {
+		return errors.New("parameter fn is missing")
+	}
+	walkNode(Path{}, object, fn)
+	return nil
+}
+
+// walkNode visits node and its children, returns false once fn asks to stop
+//
+// walkNode 访问节点及其子节点，当 fn 要求停止时返回 false
+func walkNode(path Path, node *simplejson.Json, fn WalkFunc) bool {
+	value := node.Interface()
+	kind := kindOf(value)
+	switch fn(path, node, kind) {
+	case WalkStop:
+		return false
+	case WalkSkip:
+		return true
+	}
+	switch kind {
+	case KindObject:
+		fields := toGeneric(value).(map[string]interface{})
+		for _, key := range sortedKeys(fields) {
+			if !walkNode(appendPath(path, PathSegment{Key: key}), Wrap(fields[key]), fn) {
+				return false
+			}
+		}
+	case KindArray:
+		for idx, elem := range toGeneric(value).([]interface{}) {
+			if !walkNode(appendPath(path, PathSegment{Index: idx, IsIndex: true}), Wrap(elem), fn) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// appendPath returns new Path extending path by segment without sharing its backing array
+//
+// appendPath 返回在 path 后追加 segment 的新 Path，不与原路径共享底层数组
+func appendPath(path Path, segment PathSegment) Path {
+	res := make(Path, len(path), len(path)+1)
+	copy(res, path)
+	return append(res, segment)
+}
@@ -0,0 +1,95 @@
+package simplejsonx_test
+
+import (
+	"testing"
+
+	"github.com/bitly/go-simplejson"
+	"github.com/stretchr/testify/require"
+	"github.com/yyle88/simplejsonx"
+)
+
+func TestWalk(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"data": {"items": [{"price": 3}, {"price": 5}]}, "a.b": null, "ok": true}`))
+	require.NoError(t, err)
+
+	var visits []string
+	err = simplejsonx.Walk(object, func(path simplejsonx.Path, node *simplejson.Json, kind simplejsonx.Kind) simplejsonx.WalkAction {
+		visits = append(visits, path.String()+"="+kind.String())
+		return simplejsonx.WalkContinue
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"=object",
+		`["a.b"]=null`,
+		"data=object",
+		"data.items=array",
+		"data.items[0]=object",
+		"data.items[0].price=number",
+		"data.items[1]=object",
+		"data.items[1].price=number",
+		"ok=bool",
+	}, visits)
+}
+
+func TestWalk_SkipAndStop(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"a": {"x": 1}, "b": [1, 2, 3], "c": "s"}`))
+	require.NoError(t, err)
+
+	var visits []string
+	err = simplejsonx.Walk(object, func(path simplejsonx.Path, node *simplejson.Json, kind simplejsonx.Kind) simplejsonx.WalkAction {
+		visits = append(visits, path.String())
+		switch path.String() {
+		case "a":
+			return simplejsonx.WalkSkip
+		case "b[1]":
+			return simplejsonx.WalkStop
+		}
+		return simplejsonx.WalkContinue
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"", "a", "b", "b[0]", "b[1]"}, visits)
+}
+
+func TestWalk_Redact(t *testing.T) {
+	object, err := simplejsonx.Load([]byte(`{"user": {"name": "a", "password": "p"}, "tokens": [{"password": "q"}]}`))
+	require.NoError(t, err)
+
+	err = simplejsonx.Walk(object, func(path simplejsonx.Path, node *simplejson.Json, kind simplejsonx.Kind) simplejsonx.WalkAction {
+		if kind == simplejsonx.KindObject {
+			if _, exist := node.CheckGet("password"); exist {
+				node.Set("password", "***")
+			}
+		}
+		return simplejsonx.WalkContinue
+	})
+	require.NoError(t, err)
+
+	data, err := simplejsonx.Dump(object)
+	require.NoError(t, err)
+	require.Equal(t, `{"tokens":[{"password":"***"}],"user":{"name":"a","password":"***"}}`, string(data))
+}
+
+func TestWalk_TypedContainers(t *testing.T) {
+	object := simplejsonx.Wrap(map[string]interface{}{"tags": []string{"x", "y"}, "scores": map[string]int{"m": 1}})
+
+	var visits []string
+	err := simplejsonx.Walk(object, func(path simplejsonx.Path, node *simplejson.Json, kind simplejsonx.Kind) simplejsonx.WalkAction {
+		visits = append(visits, path.String()+"="+kind.String())
+		return simplejsonx.WalkContinue
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"=object", "scores=object", "scores.m=number", "tags=array", "tags[0]=string", "tags[1]=string"}, visits)
+}
+
+func TestPath_String(t *testing.T) {
+	path := simplejsonx.Path{
+		{Key: "data"},
+		{Key: "items"},
+		{Index: 3, IsIndex: true},
+		{Key: "price"},
+	}
+	require.Equal(t, "data.items[3].price", path.String())
+	require.Equal(t, "[0][1]", simplejsonx.Path{{IsIndex: true}, {Index: 1, IsIndex: true}}.String())
+	require.Equal(t, `a[""]`, simplejsonx.Path{{Key: "a"}, {Key: ""}}.String())
+	require.Equal(t, "", simplejsonx.Path{}.String())
+}
@@ -1,6 +1,8 @@
 package simplejsonx
 
 import (
+	"encoding/json"
+
 	"github.com/bitly/go-simplejson"
 	"github.com/pkg/errors"
 )
@@ -8,14 +10,16 @@ import (
 // Load creates simplejson.Json instance from raw JSON bytes
 // Parses byte data into structured JSON object representation
 // Returns errors with context when JSON parsing fails
+// Syntax errors become SyntaxError carrying line, column and surrounding text
 //
 // Load 从原始 JSON 字节创建 simplejson.Json 实例
 // 将字节数据解析成结构化的 JSON 对象表示
 // 当 JSON 解析失败时返回带上下文的错误
+// 语法错误会转换成带有行号、列号和上下文文本的 SyntaxError
 func Load(data []byte) (object *simplejson.Json, err error) {
 	object, err = simplejson.NewJson(data)
 	if err != nil {
-		return simplejson.New(), errors.WithMessage(err, "unable to parse JSON")
+		return simplejson.New(), errors.WithMessage(withSyntaxPosition(data, err), "unable to parse JSON")
 	}
 	return object, nil
 }
@@ -28,22 +32,43 @@ func Load(data []byte) (object *simplejson.Json, err error) {
 // 将任何 Go 数据结构封装成 JSON 对象表示
 // 在原生 Go 值上实现统一的 JSON 操作
 func Wrap(value interface{}) (object *simplejson.Json) {
-	object = simplejson.New()
-	object.SetPath([]string{}, value)
+	object = &simplejson.Json{} // skip the placeholder map simplejson.New allocates
+	object.SetPath(nil, value)
 	return object
 }
 
+// WrapNormalized creates simplejson.Json instance holding generic JSON tree built from given Go value
+// Structs, typed maps and typed slices are encoded honouring json tags and MarshalJSON
+// The result holds only map[string]interface{}, []interface{}, json.Number and basic values
+// so every read API works the same as on data produced by Load
+//
+// WrapNormalized 根据给定的 Go 值创建持有通用 JSON 树的 simplejson.Json 实例
+// 结构体、具体类型的映射和切片会按照 json 标签和 MarshalJSON 进行编码
+// 结果仅包含 map[string]interface{}、[]interface{}、json.Number 和基础值
+// 因此所有读取 API 的行为与作用于 Load 得到的数据时一致
+func WrapNormalized(value interface{}) (object *simplejson.Json, err error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return simplejson.New(), errors.WithMessage(err, "unable to normalize value into JSON")
+	}
+	return Load(data)
+}
+
 // List converts slice of interface{} values into simplejson.Json objects slice
 // Wraps each element as distinct JSON object instances
 // Provides batch conversion enabling uniform processing of heterogeneous data
+// Objects share one backing allocation, holding any one of them keeps the whole batch alive
 //
 // List 将 interface{} 值切片转换成 simplejson.Json 对象切片
 // 独立包装每个元素，创建独立的 JSON 对象实例
 // 提供批量转换，实现对异构数据的统一处理
+// 所有对象共用一次底层分配，持有其中任意一个都会使整批对象保持存活
 func List(elements []interface{}) (objects []*simplejson.Json) {
-	objects = make([]*simplejson.Json, 0, len(elements))
-	for _, elem := range elements {
-		objects = append(objects, Wrap(elem))
+	backing := make([]simplejson.Json, len(elements))
+	objects = make([]*simplejson.Json, len(elements))
+	for idx, elem := range elements {
+		backing[idx].SetPath(nil, elem)
+		objects[idx] = &backing[idx]
 	}
 	return objects
 }
@@ -3,6 +3,7 @@ package simplejsonx_test
 import (
 	"testing"
 
+	"github.com/bitly/go-simplejson"
 	"github.com/stretchr/testify/require"
 	"github.com/yyle88/simplejsonx"
 )
@@ -75,6 +76,49 @@ func TestWrap_InvalidNone(t *testing.T) {
 	}
 }
 
+func TestWrapNormalized(t *testing.T) {
+	type Profile struct {
+		Age  int      `json:"age"`
+		Tags []string `json:"tags,omitempty"`
+	}
+	type User struct {
+		Name    string            `json:"name"`
+		Profile *Profile          `json:"profile"`
+		Extra   map[string]int    `json:"extra"`
+		Skipped string            `json:"-"`
+		Labels  map[string]string `json:"labels,omitempty"`
+	}
+	value := User{Name: "yyle88", Profile: &Profile{Age: 18}, Extra: map[string]int{"score": 90}, Skipped: "x"}
+
+	object, err := simplejsonx.WrapNormalized(value)
+	require.NoError(t, err)
+
+	name, err := simplejsonx.Extract[string](object, "name")
+	require.NoError(t, err)
+	require.Equal(t, "yyle88", name)
+
+	age, exist, err := simplejsonx.Explore[int](object, "profile.age")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, 18, age)
+
+	score, exist, err := simplejsonx.Explore[int64](object, "extra.score")
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, int64(90), score)
+
+	_, exist = object.CheckGet("Skipped")
+	require.False(t, exist)
+	_, exist = object.CheckGet("labels")
+	require.False(t, exist)
+
+	_, err = simplejsonx.Extract[string](simplejsonx.Wrap(value), "name")
+	require.Error(t, err)
+
+	_, err = simplejsonx.WrapNormalized(make(chan int))
+	require.Error(t, err)
+}
+
 func TestList(t *testing.T) {
 	data := []byte(`{
 	"infos": [
@@ -101,3 +145,52 @@ func TestList(t *testing.T) {
 	}
 	require.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, resMap)
 }
+
+func TestList_Independent(t *testing.T) {
+	elements := simplejsonx.List([]interface{}{map[string]interface{}{"v": 1}, map[string]interface{}{"v": 2}, nil})
+	require.Len(t, elements, 3)
+
+	elements[0].Set("v", 10)
+	require.Equal(t, 10, elements[0].Get("v").MustInt())
+	require.Equal(t, 2, elements[1].Get("v").MustInt())
+	require.Nil(t, elements[2].Interface())
+
+	require.Empty(t, simplejsonx.List(nil))
+}
+
+func newBenchmarkElements(size int) []interface{} {
+	elements := make([]interface{}, size)
+	for idx := range elements {
+		elements[idx] = map[string]interface{}{"id": idx}
+	}
+	return elements
+}
+
+// listByWrap is the former List implementation, kept as the benchmark baseline
+func listByWrap(elements []interface{}) []*simplejson.Json {
+	objects := make([]*simplejson.Json, 0, len(elements))
+	for _, elem := range elements {
+		object := simplejson.New()
+		object.SetPath([]string{}, elem)
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+func BenchmarkList(b *testing.B) {
+	elements := newBenchmarkElements(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for idx := 0; idx < b.N; idx++ {
+		_ = simplejsonx.List(elements)
+	}
+}
+
+func BenchmarkList_WrapEach(b *testing.B) {
+	elements := newBenchmarkElements(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for idx := 0; idx < b.N; idx++ {
+		_ = listByWrap(elements)
+	}
+}